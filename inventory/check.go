@@ -0,0 +1,58 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rwcarlsen/source-sink/inventory/inv"
+)
+
+func doCheck(args []string) {
+	fs := flag.NewFlagSet("check", flag.ExitOnError)
+	simid := fs.String("simid", "", "Comma separated simulation ids (or id prefixes) to check (default all).")
+	in := fs.String("in", "", "Cyclus sqlite db the inventory db was built from with -out, read for the cyclus tables.")
+	tol := fs.Float64("tol", inv.DefaultCheckTol, "Relative tolerance for comparing quantities.")
+	fs.Usage = func() {
+		fmt.Println("Usage: inventory check [-simid ids] [-in cyclus-db] [db]")
+		fmt.Println("Verifies conservation of mass across resource splits, combines, creation")
+		fmt.Println("and agent inventories, printing each violation found.  Inventories must")
+		fmt.Println("already be built.  Exits with status 1 if any violations are found.")
+		fmt.Println()
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	conn, err := openWithInput(fs.Arg(0), *in)
+	fatalif(err)
+	defer conn.Close()
+
+	simids, err := inv.GetSimIds(conn)
+	fatalif(err)
+	if *simid != "" {
+		simids, err = inv.FilterSimIds(simids, strings.Split(*simid, ",")...)
+		fatalif(err)
+	}
+
+	n := 0
+	for _, id := range simids {
+		vs, err := inv.Check(conn, id, *tol)
+		fatalif(err)
+		for _, v := range vs {
+			fmt.Printf("simid %v: %v\n", id, v)
+		}
+		n += len(vs)
+	}
+
+	if n > 0 {
+		fmt.Printf("%v violations found\n", n)
+		os.Exit(1)
+	}
+	fmt.Println("No violations found.")
+}
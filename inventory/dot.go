@@ -0,0 +1,42 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"os"
+
+	"github.com/rwcarlsen/source-sink/inventory/inv"
+)
+
+func doDot(args []string) {
+	fs := flag.NewFlagSet("dot", flag.ExitOnError)
+	simid := fs.String("simid", "", "Simulation id (may be omitted if the db holds only one).")
+	in := fs.String("in", "", "Cyclus sqlite db the inventory db was built from with -out, read for the cyclus tables.")
+	agent := fs.Int("agent", -1, "Id of the agent to graph resources for.")
+	from := fs.Int("from", 0, "Only include resources held by the agent at or after this time.")
+	to := fs.Int("to", math.MaxInt32, "Only include resources created or transferred at or before this time.")
+	fs.Usage = func() {
+		fmt.Println("Usage: inventory dot [-simid id] [-in cyclus-db] -agent id [-from t0] [-to t1] [db]")
+		fmt.Println("Prints a graphviz DOT graph of the heritage of resources passing through an agent.")
+		fmt.Println()
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 1 || *agent < 0 {
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	conn, err := openWithInput(fs.Arg(0), *in)
+	fatalif(err)
+	defer conn.Close()
+
+	id, err := pickSimId(conn, *simid)
+	fatalif(err)
+
+	g, err := inv.AgentGraph(conn, id, *agent, *from, *to)
+	fatalif(err)
+	fatalif(g.WriteDot(os.Stdout))
+}
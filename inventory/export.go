@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/rwcarlsen/source-sink/inventory/inv"
+)
+
+func doExport(args []string) {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	simid := fs.String("simid", "", "Simulation id (may be omitted if the db holds only one).")
+	in := fs.String("in", "", "Cyclus sqlite db the inventory db was built from with -out, read for the cyclus tables.")
+	format := fs.String("format", "csv", "Output format (csv or jsonl).")
+	out := fs.String("o", "", "File to write to (default stdout).")
+	join := fs.Bool("join", false, "Include each owning agent's prototype and resource's quantity.")
+	fs.Usage = func() {
+		fmt.Println("Usage: inventory export [-simid id] [-in cyclus-db] [-format csv|jsonl] [-o file] [db]")
+		fmt.Println("Streams the inventory intervals of a simulation for loading elsewhere.")
+		fmt.Println()
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	conn, err := openWithInput(fs.Arg(0), *in)
+	fatalif(err)
+	defer conn.Close()
+
+	id, err := pickSimId(conn, *simid)
+	fatalif(err)
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		fatalif(err)
+		defer f.Close()
+		w = f
+	}
+
+	bw := bufio.NewWriter(w)
+	fatalif(writeExport(bw, *format, conn, id, *join))
+	fatalif(bw.Flush())
+}
+
+type exportRecord struct {
+	SimID     string
+	ResID     int
+	AgentID   int
+	StartTime int
+	EndTime   int
+	EndReason string
+	Prototype string   `json:",omitempty"`
+	Quantity  *float64 `json:",omitempty"`
+}
+
+func writeExport(w io.Writer, format string, conn *inv.Conn, simid string, join bool) error {
+	switch format {
+	case "csv":
+		cw := csv.NewWriter(w)
+		hdr := []string{"SimID", "ResID", "AgentID", "StartTime", "EndTime", "EndReason"}
+		if join {
+			hdr = append(hdr, "Prototype", "Quantity")
+		}
+		cw.Write(hdr)
+		err := inv.Export(conn, simid, join, func(r *inv.ExportRow) error {
+			rec := []string{simid, strconv.Itoa(r.ResId), strconv.Itoa(r.OwnerId),
+				strconv.Itoa(r.StartTime), strconv.Itoa(r.EndTime), r.EndReason}
+			if join {
+				rec = append(rec, r.Prototype, strconv.FormatFloat(r.Quantity, 'g', -1, 64))
+			}
+			return cw.Write(rec)
+		})
+		cw.Flush()
+		if err != nil {
+			return err
+		}
+		return cw.Error()
+	case "jsonl":
+		enc := json.NewEncoder(w)
+		return inv.Export(conn, simid, join, func(r *inv.ExportRow) error {
+			rec := exportRecord{simid, r.ResId, r.OwnerId, r.StartTime, r.EndTime, r.EndReason, "", nil}
+			if join {
+				rec.Prototype, rec.Quantity = r.Prototype, &r.Quantity
+			}
+			return enc.Encode(rec)
+		})
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+}
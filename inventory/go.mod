@@ -0,0 +1,9 @@
+module github.com/rwcarlsen/source-sink/inventory
+
+go 1.21
+
+require (
+	github.com/lib/pq v1.12.3
+	github.com/mattn/go-sqlite3 v1.14.52
+	gonum.org/v1/hdf5 v0.0.0-20210714002203-8c5d23bc6946
+)
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"log"
+	"os"
+	"strings"
+
+	"github.com/rwcarlsen/source-sink/inventory/inv"
+)
+
+// isHDF5 reports whether the cyclus output named on the command line is an
+// hdf5 file rather than a database.
+func isHDF5(fname string) bool {
+	if (*driver != "" && *driver != sqliteDriver) || strings.Contains(fname, "://") {
+		return false
+	}
+	ok, err := inv.IsHDF5(fname)
+	if os.IsNotExist(err) {
+		return false
+	}
+	fatalif(err)
+	return ok
+}
+
+// walkHDF5 builds inventories for the simulations in the cyclus hdf5 output
+// file fname, writing them to the sqlite db named by -out.  The simulations
+// are walked one at a time from memory, as if with -mem; -j is refused.
+func walkHDF5(fname string) {
+	if *out == "" {
+		log.Fatalf("%v is an hdf5 file: name the sqlite db to write inventories to with -out", fname)
+	} else if *njobs > 1 || *nuc || *valid || *strict {
+		log.Fatal("-j, -nuc, -validate and -strict are not supported for hdf5 files")
+	}
+
+	input, err := inv.OpenHDF5(fname)
+	fatalif(err)
+	conn, err := inv.Open(sqliteDriver, *out)
+	fatalif(err)
+
+	// the cyclus tables aren't in the output db, so there's nothing to index
+	fatalif(inv.PrepareWith(conn, inv.IndexOptions{Skip: true}))
+	finish(conn, buildHDF5(conn, input))
+}
+
+// buildHDF5 builds inventories in conn for the simulations in input chosen
+// on the command line.
+func buildHDF5(conn *inv.Conn, input *inv.HDF5Input) error {
+	simids, err := input.SimIds()
+	if err != nil {
+		return err
+	}
+	simids, err = selectSimIds(conn, simids)
+	if err != nil {
+		return err
+	}
+	for _, simid := range simids {
+		store, err := input.Store(simid, inv.Build{Quantities: *qty, Flows: *flows})
+		if err != nil {
+			return err
+		}
+		ctx := inv.NewContext(conn, simid, nil)
+		configure(ctx)
+		ctx.Source = store
+		if err := ctx.WalkAll(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package inv
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+)
+
+// InputSchema is the schema name under which AttachInput attaches the
+// cyclus database being read.
+const InputSchema = "cyclus"
+
+// AttachInput attaches the cyclus database at path read-only to conn so that
+// inventories can be written to conn's own (output) database while the raw
+// cyclus tables are read from path.  Since conn's database has no tables of
+// the same names, unqualified queries of the cyclus tables resolve to the
+// attached database.  Prepare leaves the attached database untouched,
+// including not indexing it, so walking is best done with
+// Context.InMemory set.  The schema of conn is set to that of the attached
+// database.  Only sqlite databases can be attached.
+func AttachInput(conn *Conn, path string) error {
+	if conn.Dialect != SQLite {
+		return fmt.Errorf("attaching input database %v: not supported by %v databases", path, conn.Dialect)
+	}
+	// the uri must hold an absolute path, else its first element is taken
+	// for the authority; url escapes any ? and # in it.
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return fmt.Errorf("attaching input database %v: %w", path, err)
+	}
+	uri := (&url.URL{Scheme: "file", Path: filepath.ToSlash(abs), RawQuery: "mode=ro"}).String()
+	if err := conn.Exec("ATTACH DATABASE ? AS "+InputSchema+";", uri); err != nil {
+		return fmt.Errorf("attaching input database %v: %w", path, err)
+	}
+	return conn.detect()
+}
+
+// inputAttached reports whether a cyclus database has been attached to conn
+// with AttachInput.
+func inputAttached(conn *Conn) (bool, error) {
+	if conn.Dialect != SQLite {
+		return false, nil
+	}
+
+	rows, err := conn.Query("SELECT name FROM pragma_database_list;")
+	if err != nil {
+		return false, fmt.Errorf("listing attached databases: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return false, fmt.Errorf("listing attached databases: %w", err)
+		}
+		if name == InputSchema {
+			return true, nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return false, fmt.Errorf("listing attached databases: %w", err)
+	}
+	return false, nil
+}
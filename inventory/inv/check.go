@@ -0,0 +1,297 @@
+package inv
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// DefaultCheckTol is the relative tolerance Check is normally used with.
+// Cyclus output can record quantities to only six significant figures (as
+// text formatted by older versions), so the quantities of split and combined
+// resources and the sums of agent inventories can be off by a few parts per
+// million.
+const DefaultCheckTol = 1e-5
+
+var (
+	checkResSql  = "SELECT {Resources.ID},TimeCreated,Quantity,Parent1,Parent2 FROM Resources WHERE SimID = ?;"
+	checkRootSql = "SELECT {ResCreators.ResID},{ResCreators.ModelID} FROM ResCreators WHERE SimID = ?;"
+	checkTxSql   = `SELECT tr.Time,tr.SenderID,tr.ReceiverID,res.Quantity FROM Transactions AS tr
+				  INNER JOIN {TransactedResources} AS trr ON tr.{Transactions.ID} = trr.TransactionID
+				  INNER JOIN Resources AS res ON trr.ResourceID = res.{Resources.ID}
+				  WHERE tr.SimID = ?1 AND trr.SimID = ?1 AND res.SimID = ?1;`
+	checkAgentSql = "SELECT {Agents.ID} FROM {Agents} WHERE SimID = ? ORDER BY {Agents.ID} ASC;"
+)
+
+// Violation kinds reported by Check.
+const (
+	// BadSplit means the resources split from a parent don't add up to it.
+	BadSplit = "split"
+	// BadCombine means a resource isn't the sum of the two it combined.
+	BadCombine = "combine"
+	// NoCreator means a resource without parents has no ResCreators entry.
+	NoCreator = "no-creator"
+	// BadCreator means a ResCreators entry refers to a resource that was
+	// derived from others or doesn't exist.
+	BadCreator = "bad-creator"
+	// MissingParent means a resource refers to a parent that doesn't exist.
+	MissingParent = "missing-parent"
+	// BadInventory means an agent's inventory doesn't match what it has
+	// created, received and sent.
+	BadInventory = "inventory"
+)
+
+// Violation describes a single mass balance failure found by Check.
+type Violation struct {
+	Kind string
+	// ResId is the resource involved, if any.
+	ResId int
+	// AgentId is the agent involved, if any.
+	AgentId int
+	Time    int
+	// Want and Got are the expected and actual quantities.
+	Want float64
+	Got  float64
+}
+
+func (v Violation) String() string {
+	switch v.Kind {
+	case BadInventory:
+		return fmt.Sprintf("%v: agent %v at t=%v: expected quantity %v, got %v", v.Kind, v.AgentId, v.Time, v.Want, v.Got)
+	case NoCreator, BadCreator, MissingParent:
+		return fmt.Sprintf("%v: resid %v at t=%v", v.Kind, v.ResId, v.Time)
+	default:
+		return fmt.Sprintf("%v: resid %v at t=%v: expected quantity %v, got %v", v.Kind, v.ResId, v.Time, v.Want, v.Got)
+	}
+}
+
+type checkRes struct {
+	id, time int
+	qty      float64
+	p1, p2   int
+}
+
+// Check verifies conservation of mass across the resource graph of
+// simulation simid and returns every violation found:
+//
+//   - resources split from a parent must add up to the parent
+//   - a resource combined from two parents must equal their sum
+//   - every resource without parents must be a root recorded in ResCreators
+//   - each agent's inventory from the Inventories table must equal the
+//     quantity it has created and received less what it has sent
+//
+// The Inventories table must already have been built for simid.  Inventory
+// violations are only reported at the times an agent's discrepancy changes.
+// Quantities are considered equal if they differ by no more than tol
+// relative to the larger of them (or absolutely for magnitudes below one).
+func Check(conn *Conn, simid string, tol float64) (vs []Violation, err error) {
+	res, err := loadCheckRes(conn, simid)
+	if err != nil {
+		return nil, fmt.Errorf("simid %v: %w", simid, err)
+	}
+
+	creators, err := loadCreators(conn, simid)
+	if err != nil {
+		return nil, fmt.Errorf("simid %v: %w", simid, err)
+	}
+
+	vs = append(vs, checkGraph(res, creators, tol)...)
+
+	agentVs, err := checkAgents(conn, simid, res, creators, tol)
+	if err != nil {
+		return nil, err
+	}
+	return append(vs, agentVs...), nil
+}
+
+// loadCheckRes loads the heritage and quantity of every resource in simid.
+func loadCheckRes(conn *Conn, simid string) (res map[int]*checkRes, err error) {
+	rows, err := conn.Query(checkResSql, conn.simId(simid))
+	if err != nil {
+		return nil, fmt.Errorf("loading resources: %w", err)
+	}
+	defer rows.Close()
+
+	res = map[int]*checkRes{}
+	for rows.Next() {
+		r := &checkRes{}
+		if err := rows.Scan(&r.id, &r.time, &r.qty, &r.p1, &r.p2); err != nil {
+			return nil, fmt.Errorf("loading resources: %w", err)
+		}
+		res[r.id] = r
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("loading resources: %w", err)
+	}
+	return res, nil
+}
+
+// loadCreators loads the creating agent of every root resource in simid.
+func loadCreators(conn *Conn, simid string) (creators map[int]int, err error) {
+	rows, err := conn.Query(checkRootSql, conn.simId(simid))
+	if err != nil {
+		return nil, fmt.Errorf("loading resource creators: %w", err)
+	}
+	defer rows.Close()
+
+	creators = map[int]int{}
+	for rows.Next() {
+		var id, agent int
+		if err := rows.Scan(&id, &agent); err != nil {
+			return nil, fmt.Errorf("loading resource creators: %w", err)
+		}
+		creators[id] = agent
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("loading resource creators: %w", err)
+	}
+	return creators, nil
+}
+
+// checkGraph checks splits, combines and roots of the resource graph.
+func checkGraph(res map[int]*checkRes, creators map[int]int, tol float64) (vs []Violation) {
+	ids := make([]int, 0, len(res))
+	for id := range res {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	splits := map[int]float64{}
+	for _, id := range ids {
+		r := res[id]
+		switch {
+		case r.p1 == 0 && r.p2 == 0:
+			if _, ok := creators[id]; !ok {
+				vs = append(vs, Violation{Kind: NoCreator, ResId: id, Time: r.time})
+			}
+			continue
+		case r.p1 != 0 && r.p2 != 0:
+			p1, ok1 := res[r.p1]
+			p2, ok2 := res[r.p2]
+			if ok1 && ok2 && !qtyEqual(r.qty, p1.qty+p2.qty, tol) {
+				vs = append(vs, Violation{Kind: BadCombine, ResId: id, Time: r.time, Want: p1.qty + p2.qty, Got: r.qty})
+			}
+		default:
+			splits[r.p1+r.p2] += r.qty
+		}
+
+		for _, p := range []int{r.p1, r.p2} {
+			if _, ok := res[p]; p != 0 && !ok {
+				vs = append(vs, Violation{Kind: MissingParent, ResId: id, Time: r.time})
+			}
+		}
+		if _, ok := creators[id]; ok {
+			vs = append(vs, Violation{Kind: BadCreator, ResId: id, Time: r.time})
+		}
+	}
+
+	for _, id := range ids {
+		tot, ok := splits[id]
+		if ok && !qtyEqual(tot, res[id].qty, tol) {
+			vs = append(vs, Violation{Kind: BadSplit, ResId: id, Time: res[id].time, Want: res[id].qty, Got: tot})
+		}
+	}
+
+	for id := range creators {
+		if _, ok := res[id]; !ok {
+			vs = append(vs, Violation{Kind: BadCreator, ResId: id})
+		}
+	}
+	return vs
+}
+
+// checkAgents compares each agent's inventory time series to its cumulative
+// creations and transactions.
+func checkAgents(conn *Conn, simid string, res map[int]*checkRes, creators map[int]int, tol float64) (vs []Violation, err error) {
+	dur, err := Duration(conn, simid)
+	if err != nil {
+		return nil, err
+	}
+
+	// net quantity change for each agent at each time
+	deltas := map[int][]float64{}
+	add := func(agent, t int, qty float64) {
+		if t < 0 || t >= dur {
+			return
+		}
+		if deltas[agent] == nil {
+			deltas[agent] = make([]float64, dur)
+		}
+		deltas[agent][t] += qty
+	}
+
+	for id, agent := range creators {
+		if r, ok := res[id]; ok {
+			add(agent, r.time, r.qty)
+		}
+	}
+
+	rows, err := conn.Query(checkTxSql, conn.simId(simid))
+	if err != nil {
+		return nil, fmt.Errorf("simid %v: loading transactions: %w", simid, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var t, sender, receiver int
+		var qty float64
+		if err := rows.Scan(&t, &sender, &receiver, &qty); err != nil {
+			return nil, fmt.Errorf("simid %v: loading transactions: %w", simid, err)
+		}
+		add(sender, t, -qty)
+		add(receiver, t, qty)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("simid %v: loading transactions: %w", simid, err)
+	}
+
+	var agents []int
+	rows, err = conn.Query(checkAgentSql, conn.simId(simid))
+	if err != nil {
+		return nil, fmt.Errorf("simid %v: retrieving agents: %w", simid, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("simid %v: retrieving agents: %w", simid, err)
+		}
+		agents = append(agents, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("simid %v: retrieving agents: %w", simid, err)
+	}
+
+	// inventories of decommissioned agents are only compared while they
+	// were alive since their resources disappear with them.
+	deaths, err := agentDeaths(conn, simid)
+	if err != nil {
+		return nil, fmt.Errorf("simid %v: %w", simid, err)
+	}
+
+	for _, agent := range agents {
+		series, err := Series(conn, simid, agent)
+		if err != nil {
+			return nil, err
+		}
+		if death, ok := deaths[agent]; ok && death >= 0 && death < len(series) {
+			series = series[:death]
+		}
+
+		want, prevDiff := 0.0, 0.0
+		for t, got := range series {
+			if d := deltas[agent]; d != nil {
+				want += d[t]
+			}
+			diff := got - want
+			if !qtyEqual(got, want, tol) && !qtyEqual(diff, prevDiff, tol) {
+				vs = append(vs, Violation{Kind: BadInventory, AgentId: agent, Time: t, Want: want, Got: got})
+			}
+			prevDiff = diff
+		}
+	}
+	return vs, nil
+}
+
+func qtyEqual(a, b, tol float64) bool {
+	return math.Abs(a-b) <= tol*math.Max(1, math.Max(math.Abs(a), math.Abs(b)))
+}
@@ -0,0 +1,263 @@
+package inv
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Dialect identifies the flavor of SQL spoken by a database.
+type Dialect int
+
+const (
+	// SQLite is spoken by cyclus output databases as written by cyclus.
+	SQLite Dialect = iota
+	// Postgres is spoken by PostgreSQL copies of cyclus output databases.
+	// Their tables must have been created with unquoted (i.e. case
+	// insensitive) names.
+	Postgres
+)
+
+func (d Dialect) String() string {
+	if d == Postgres {
+		return "postgres"
+	}
+	return "sqlite"
+}
+
+// driverDialects maps database/sql driver names to the dialect of the
+// databases they connect to.  Drivers not listed are assumed to be for
+// sqlite.
+var driverDialects = map[string]Dialect{
+	"postgres": Postgres,
+	"pgx":      Postgres,
+}
+
+// DriverDialect returns the dialect spoken by databases opened with the
+// database/sql driver registered as driver.
+func DriverDialect(driver string) Dialect {
+	return driverDialects[driver]
+}
+
+var (
+	pgHasTableSql = `SELECT COUNT(*) FROM information_schema.tables
+				  WHERE table_name::text = lower(?) AND table_schema::text = ANY(current_schemas(true)::text[]);`
+	pgColumnsSql = `SELECT column_name FROM information_schema.columns
+				  WHERE table_name::text = lower(?) AND table_schema::text = ANY(current_schemas(true)::text[]);`
+	pgHasIndexSql = `SELECT COUNT(*) FROM pg_indexes
+				  WHERE indexname::text = lower(?) AND schemaname::text = ANY(current_schemas(true)::text[]);`
+	pgUsedBytesSql = "SELECT pg_database_size(current_database());"
+
+	// placeholderRe matches the sqlite parameter placeholders used in this
+	// package's sql: bare ? and numbered ?N.
+	placeholderRe = regexp.MustCompile(`\?[0-9]*`)
+	// pgTypes maps the column types used in this package's table
+	// definitions to their postgres equivalents.
+	pgTypes = strings.NewReplacer(" INTEGER", " BIGINT", " REAL", " DOUBLE PRECISION")
+)
+
+// Conn is a session with a database holding cyclus output.  It wraps a
+// single database/sql connection so that temporary tables, attached
+// databases and transactions begun with BEGIN persist from one call to the
+// next.  All sql passed to a Conn is written for sqlite and is translated to
+// the Conn's Dialect.
+//
+// As some drivers can't interleave queries on one connection, the rows of a
+// query must be closed before the next query is run.
+type Conn struct {
+	conn    *sql.Conn
+	db      *sql.DB
+	Dialect Dialect
+	// Schema is the schema of the cyclus tables queried through the Conn.
+	// It is detected when the Conn is created and again when an input
+	// database is attached, and must be set by hand if the cyclus tables
+	// are created later.
+	Schema Schema
+	// blobIds is set when the cyclus tables store simulation ids as 16
+	// byte blobs rather than text, as cyclus 1.x sqlite output does.  It is
+	// detected along with Schema.
+	blobIds bool
+}
+
+// Open opens the database named by dsn using the database/sql driver
+// registered as driver (e.g. "sqlite3" or "postgres") and returns a session
+// with it.  For sqlite drivers, dsn is typically just the database's file
+// name.  Closing the session closes the database.
+func Open(driver, dsn string) (*Conn, error) {
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := NewConn(db, DriverDialect(driver))
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	conn.db = db
+	return conn, nil
+}
+
+// NewConn returns a new session with db, which speaks dialect d, detecting
+// the schema of its cyclus tables.  Closing the session returns its
+// connection to db's pool without closing db.
+func NewConn(db *sql.DB, d Dialect) (*Conn, error) {
+	conn, err := db.Conn(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	c := &Conn{conn: conn, Dialect: d}
+	if err := c.detect(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// detect detects the schema of the cyclus tables and how they store
+// simulation ids.
+func (c *Conn) detect() (err error) {
+	if c.Schema, err = DetectSchema(c); err != nil {
+		return err
+	}
+	c.blobIds = false
+	if c.Dialect != SQLite || c.Schema != Schema1 {
+		return nil
+	}
+
+	var typ string
+	err = c.QueryRow("SELECT typeof(SimID) FROM {SimulationTimeInfo} LIMIT 1;").Scan(&typ)
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("detecting simulation id type: %w", err)
+	}
+	c.blobIds = typ == "blob"
+	return nil
+}
+
+// simId returns simid as it must be bound to match the SimID columns of the
+// cyclus tables: the 16 bytes of the uuid if they are stored as blobs and
+// the text of simid otherwise.  Ids that aren't uuids are bound as text and
+// so match nothing in such tables.
+func (c *Conn) simId(simid string) interface{} {
+	if !c.blobIds {
+		return simid
+	}
+	b, err := parseUUID(simid)
+	if err != nil {
+		return simid
+	}
+	return b
+}
+
+// Close ends the session, closing its database if it was opened with Open.
+func (c *Conn) Close() error {
+	err := c.conn.Close()
+	if c.db != nil {
+		if err2 := c.db.Close(); err == nil {
+			err = err2
+		}
+	}
+	return err
+}
+
+// Exec executes query, which returns no rows, with the given arguments.
+func (c *Conn) Exec(query string, args ...interface{}) error {
+	_, err := c.conn.ExecContext(context.Background(), c.rebind(query), args...)
+	return err
+}
+
+// Query executes query with the given arguments and returns its rows.
+func (c *Conn) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return c.conn.QueryContext(context.Background(), c.rebind(query), args...)
+}
+
+// QueryRow executes query, which returns at most one row, with the given
+// arguments.
+func (c *Conn) QueryRow(query string, args ...interface{}) *sql.Row {
+	return c.conn.QueryRowContext(context.Background(), c.rebind(query), args...)
+}
+
+// Prepare creates a prepared statement for later queries or executions on
+// the session.
+func (c *Conn) Prepare(query string) (*sql.Stmt, error) {
+	return c.conn.PrepareContext(context.Background(), c.rebind(query))
+}
+
+// rebind translates the cyclus table and column names of query to the
+// Conn's schema and its parameter placeholders to the Conn's dialect.  Bare
+// placeholders are numbered in order of appearance.
+func (c *Conn) rebind(query string) string {
+	query = c.Schema.translate(query)
+	if c.Dialect != Postgres {
+		return query
+	}
+	n := 0
+	return placeholderRe.ReplaceAllStringFunc(query, func(p string) string {
+		if len(p) > 1 {
+			return "$" + p[1:]
+		}
+		n++
+		return fmt.Sprintf("$%d", n)
+	})
+}
+
+// ddl translates the column types of the table definition stmt to the
+// Conn's dialect.
+func (c *Conn) ddl(stmt string) string {
+	if c.Dialect != Postgres {
+		return stmt
+	}
+	return pgTypes.Replace(stmt)
+}
+
+// setBusyTimeout makes the session wait up to d for a locked database rather
+// than failing immediately.  It has no effect on databases without locking
+// of the whole database.
+func (c *Conn) setBusyTimeout(d time.Duration) error {
+	if c.Dialect != SQLite {
+		return nil
+	}
+	return c.Exec(fmt.Sprintf("PRAGMA busy_timeout = %d;", d.Milliseconds()))
+}
+
+// hasTable reports whether the database for conn (or one attached to it)
+// contains table.
+func hasTable(conn *Conn, table string) (bool, error) {
+	query := "SELECT COUNT(*) FROM pragma_table_info(?);"
+	if conn.Dialect == Postgres {
+		query = pgHasTableSql
+	}
+	n := 0
+	if err := conn.QueryRow(query, table).Scan(&n); err != nil {
+		return false, fmt.Errorf("looking up table %v: %w", table, err)
+	}
+	return n > 0, nil
+}
+
+// columns returns the lower case names of the columns of table.
+func columns(conn *Conn, table string) (cols []string, err error) {
+	query := "SELECT name FROM pragma_table_info(?);"
+	if conn.Dialect == Postgres {
+		query = pgColumnsSql
+	}
+	rows, err := conn.Query(query, table)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %v columns: %w", table, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("retrieving %v columns: %w", table, err)
+		}
+		cols = append(cols, strings.ToLower(name))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("retrieving %v columns: %w", table, err)
+	}
+	return cols, nil
+}
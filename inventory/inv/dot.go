@@ -0,0 +1,273 @@
+package inv
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"math"
+)
+
+var (
+	agentTxSql = `SELECT trr.ResourceID,tr.Time,tr.SenderID,tr.ReceiverID,res.TimeCreated,res.Quantity
+				  FROM Transactions AS tr
+				  INNER JOIN {TransactedResources} AS trr ON tr.{Transactions.ID} = trr.TransactionID
+				  INNER JOIN Resources AS res ON trr.ResourceID = res.{Resources.ID}
+				  WHERE tr.SimID = ?1 AND trr.SimID = ?1 AND res.SimID = ?1
+				  AND (tr.SenderID = ?2 OR tr.ReceiverID = ?2) AND tr.Time <= ?3
+				  ORDER BY tr.Time ASC, tr.{Transactions.ID} ASC;`
+	agentCreatedSql = `SELECT res.{Resources.ID},res.TimeCreated,res.Quantity FROM ResCreators AS rc
+				  INNER JOIN Resources AS res ON rc.{ResCreators.ResID} = res.{Resources.ID}
+				  WHERE rc.SimID = ?1 AND res.SimID = ?1 AND rc.{ResCreators.ModelID} = ?2
+				  AND res.TimeCreated <= ?3
+				  ORDER BY res.{Resources.ID} ASC;`
+	childrenSql = `SELECT {Resources.ID},TimeCreated,Quantity FROM Resources
+				  WHERE SimID = ?1 AND (Parent1 = ?2 OR Parent2 = ?2) ORDER BY {Resources.ID} ASC;`
+)
+
+// Graph is a directed graph of resources and agents that can be rendered in
+// the graphviz DOT language.
+type Graph struct {
+	ids   map[string]bool
+	nodes []graphNode
+	edges []graphEdge
+}
+
+type graphNode struct {
+	id, label, shape string
+}
+
+type graphEdge struct {
+	from, to, label string
+}
+
+func (g *Graph) addNode(id, label, shape string) string {
+	if g.ids == nil {
+		g.ids = map[string]bool{}
+	}
+	if !g.ids[id] {
+		g.ids[id] = true
+		g.nodes = append(g.nodes, graphNode{id, label, shape})
+	}
+	return id
+}
+
+func (g *Graph) addRes(id, t int, qty float64) string {
+	label := fmt.Sprintf("Res %v\\nt=%v\\nqty %v", id, t, qty)
+	return g.addNode(fmt.Sprintf("res%v", id), label, "ellipse")
+}
+
+func (g *Graph) addAgent(id int) string {
+	return g.addNode(fmt.Sprintf("agent%v", id), fmt.Sprintf("Agent %v", id), "box")
+}
+
+func (g *Graph) addEdge(from, to, label string) {
+	g.edges = append(g.edges, graphEdge{from, to, label})
+}
+
+// WriteDot writes the graph to w in the graphviz DOT language.
+func (g *Graph) WriteDot(w io.Writer) error {
+	if _, err := fmt.Fprintln(w, "digraph G {"); err != nil {
+		return err
+	}
+	for _, n := range g.nodes {
+		if _, err := fmt.Fprintf(w, "    %v [label=\"%v\",shape=%v];\n", n.id, n.label, n.shape); err != nil {
+			return err
+		}
+	}
+	for _, e := range g.edges {
+		if _, err := fmt.Fprintf(w, "    %v -> %v [label=\"%v\"];\n", e.from, e.to, e.label); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintln(w, "}")
+	return err
+}
+
+// AgentGraph builds the heritage graph of resources passing through agent in
+// simulation simid between times t0 and t1 inclusive.  Resources enter the
+// agent by being created by it or transferred to it, and are followed
+// through splits and combinations (Parent1/Parent2) made while the agent
+// holds them, until they are created after t1.  A resource that leaves the
+// agent and later returns is followed again from its return.  Resources
+// that entered before t0 are included if they were still held at t0 or split
+// after it.  Transfers are drawn as edges to and from the other agents
+// involved.
+func AgentGraph(conn *Conn, simid string, agent, t0, t1 int) (*Graph, error) {
+	g := &Graph{}
+	var stack []graphVisit
+	info := map[int]graphRes{}
+	// moves holds each resource's transfers to and from the agent in order.
+	moves := map[int][]graphMove{}
+
+	rows, err := conn.Query(agentTxSql, conn.simId(simid), agent, t1)
+	if err != nil {
+		return nil, fmt.Errorf("simid %v: retrieving agent %v transactions: %w", simid, agent, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id, t, sender, receiver, created int
+		var qty float64
+		if err := rows.Scan(&id, &t, &sender, &receiver, &created, &qty); err != nil {
+			return nil, fmt.Errorf("simid %v: retrieving agent %v transactions: %w", simid, agent, err)
+		}
+		info[id] = graphRes{id, created, qty}
+		moves[id] = append(moves[id], graphMove{t, receiver == agent})
+		if receiver == agent {
+			stack = append(stack, graphVisit{id, false})
+		}
+		if t < t0 {
+			continue
+		}
+
+		res := g.addRes(id, created, qty)
+		label := fmt.Sprintf("t=%v\\nqty %v", t, qty)
+		if receiver == agent {
+			g.addEdge(g.addAgent(sender), res, label)
+		} else {
+			g.addEdge(res, g.addAgent(receiver), label)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("simid %v: retrieving agent %v transactions: %w", simid, agent, err)
+	}
+
+	rows, err = conn.Query(agentCreatedSql, conn.simId(simid), agent, t1)
+	if err != nil {
+		return nil, fmt.Errorf("simid %v: retrieving agent %v resources: %w", simid, agent, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var r graphRes
+		if err := rows.Scan(&r.id, &r.t, &r.qty); err != nil {
+			return nil, fmt.Errorf("simid %v: retrieving agent %v resources: %w", simid, agent, err)
+		}
+		info[r.id] = r
+		stack = append(stack, graphVisit{r.id, true})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("simid %v: retrieving agent %v resources: %w", simid, agent, err)
+	}
+
+	kidStmt, err := conn.Prepare(childrenSql)
+	if err != nil {
+		return nil, fmt.Errorf("simid %v: preparing child resource query: %w", simid, err)
+	}
+	defer kidStmt.Close()
+
+	// a resource reached both by a transfer to the agent and as a child
+	// made by it is visited once each way; linked keeps its edges to its
+	// children from being drawn twice.
+	done := map[graphVisit]bool{}
+	linked := map[[2]int]bool{}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if done[v] {
+			continue
+		}
+		done[v] = true
+
+		kids, err := children(kidStmt, conn.simId(simid), v.id, t1)
+		if err != nil {
+			return nil, fmt.Errorf("simid %v: resid %v: retrieving children: %w", simid, v.id, err)
+		}
+		r := info[v.id]
+		spans := heldSpans(r, v.born, moves[v.id])
+		drawn := false
+		if !splitBefore(kids, t0) {
+			for _, s := range spans {
+				drawn = drawn || s[1] >= t0
+			}
+		}
+		if drawn {
+			g.addRes(r.id, r.t, r.qty)
+		}
+
+		for _, kid := range kids {
+			held := false
+			for _, s := range spans {
+				held = held || (s[0] <= kid.t && kid.t < s[1])
+			}
+			if !held {
+				continue
+			}
+			info[kid.id] = kid
+			stack = append(stack, graphVisit{kid.id, true})
+			if edge := [2]int{r.id, kid.id}; drawn && !linked[edge] {
+				linked[edge] = true
+				label := fmt.Sprintf("t=%v\\nqty %v", kid.t, kid.qty)
+				g.addEdge(fmt.Sprintf("res%v", r.id), g.addRes(kid.id, kid.t, kid.qty), label)
+			}
+		}
+	}
+	return g, nil
+}
+
+// graphVisit is a resource to be followed by AgentGraph.  Born resources
+// were held by the agent from their creation.
+type graphVisit struct {
+	id   int
+	born bool
+}
+
+// graphMove is a transfer of a resource to (in) or from an agent at time t.
+type graphMove struct {
+	t  int
+	in bool
+}
+
+// heldSpans returns the intervals [from, until) over which an agent held r,
+// starting at its creation if born and at each transfer to the agent, and
+// ending at the next transfer away from it.
+func heldSpans(r graphRes, born bool, moves []graphMove) (spans [][2]int) {
+	start, holding := r.t, born
+	for _, m := range moves {
+		if m.in && !holding {
+			start, holding = m.t, true
+		} else if !m.in && holding {
+			spans = append(spans, [2]int{start, m.t})
+			holding = false
+		}
+	}
+	if holding {
+		spans = append(spans, [2]int{start, math.MaxInt})
+	}
+	return spans
+}
+
+// graphRes is a resource that may be added to a Graph.
+type graphRes struct {
+	id, t int
+	qty   float64
+}
+
+// splitBefore reports whether any of a resource's kids were created before
+// t, i.e. whether it no longer existed at t.
+func splitBefore(kids []graphRes, t int) bool {
+	for _, kid := range kids {
+		if kid.t < t {
+			return true
+		}
+	}
+	return false
+}
+
+// children returns the resources derived from parent up to time t1 using
+// the prepared child query stmt.  simid is bound as given by Conn.simId.
+func children(stmt *sql.Stmt, simid interface{}, parent, t1 int) (kids []graphRes, err error) {
+	rows, err := stmt.Query(simid, parent)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var r graphRes
+		if err := rows.Scan(&r.id, &r.t, &r.qty); err != nil {
+			return nil, err
+		}
+		if r.t <= t1 {
+			kids = append(kids, r)
+		}
+	}
+	return kids, rows.Err()
+}
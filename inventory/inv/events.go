@@ -0,0 +1,72 @@
+package inv
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EventKind identifies what happened in an Event.
+type EventKind int
+
+const (
+	// RootStart means walking begins at a root resource.  Node is the root
+	// with its creator as owner.
+	RootStart EventKind = iota
+	// Split means a resource was split or combined into the resources
+	// listed in Kids.  Node is the parent resource.
+	Split
+	// Transfer means a resource was transferred from agent From to
+	// Node.OwnerId.  Node is the resulting inventory interval.
+	Transfer
+	// Interval means an inventory interval, given by Node, was written to
+	// the Inventories table.
+	Interval
+)
+
+func (k EventKind) String() string {
+	switch k {
+	case RootStart:
+		return "root-start"
+	case Split:
+		return "split"
+	case Transfer:
+		return "transfer"
+	case Interval:
+		return "interval"
+	}
+	return fmt.Sprintf("EventKind(%d)", int(k))
+}
+
+// Event is a single step of walking a simulation, reported to a Context's
+// OnEvent callback.  RootStart, Split and Transfer events are reported as
+// the walk reaches them; Interval events are reported in batches once the
+// intervals have been written.
+type Event struct {
+	Kind  EventKind
+	Simid string
+	// Time is when the event happened in the simulation: the root's
+	// creation, the children's creation, the transfer, or the start of the
+	// interval.
+	Time int
+	Node Node
+	// Kids are the ids of the resources created by a Split.
+	Kids []int
+	// From is the agent a Transfer was made from.
+	From int
+}
+
+// SQL returns the statement that inserts the original five columns of an
+// Interval event's row into the Inventories table.
+func (e Event) SQL() string {
+	simid := strings.Replace(e.Simid, "'", "''", -1)
+	n := e.Node
+	return fmt.Sprintf("INSERT INTO Inventories VALUES('%v',%v,%v,%v,%v);", simid, n.ResId, n.OwnerId, n.StartTime, n.EndTime)
+}
+
+// emit reports e to the context's OnEvent callback if there is one.
+func (c *Context) emit(e Event) {
+	if c.OnEvent != nil {
+		e.Simid = c.Simid
+		c.OnEvent(e)
+	}
+}
@@ -0,0 +1,63 @@
+package inv
+
+import (
+	"fmt"
+)
+
+var (
+	exportSql = `SELECT inv.ResID,inv.AgentID,inv.StartTime,inv.EndTime,COALESCE(inv.EndReason,'') FROM Inventories AS inv
+				  WHERE inv.SimID = ?1;`
+	exportJoinSql = `SELECT inv.ResID,inv.AgentID,inv.StartTime,inv.EndTime,COALESCE(inv.EndReason,''),COALESCE(ag.Prototype,''),res.Quantity
+				  FROM Inventories AS inv
+				  INNER JOIN Resources AS res ON inv.ResID = res.{Resources.ID} AND res.SimID = ?2
+				  LEFT JOIN {Agents} AS ag ON inv.AgentID = ag.{Agents.ID} AND ag.SimID = ?2
+				  WHERE inv.SimID = ?1;`
+)
+
+// ExportRow is a single inventory interval as streamed by Export.
+type ExportRow struct {
+	Node
+	// Prototype is the prototype of the owning agent.  It and the node's
+	// Quantity are only set when exporting with joined columns.
+	Prototype string
+}
+
+// Export streams every inventory interval of simulation simid to fn one row
+// at a time without loading them all into memory.  If join is true, each
+// row also carries the owning agent's prototype and the resource's quantity.
+// The row passed to fn is reused between calls and must not be retained.
+// Export stops at and returns the first error returned by fn, which must not
+// itself query conn.  The Inventories table must already have been built for
+// simid.
+func Export(conn *Conn, simid string, join bool, fn func(r *ExportRow) error) (err error) {
+	// the cyclus tables may store the simulation id differently (see
+	// Conn.simId)
+	sql, args := exportSql, []interface{}{simid}
+	if join {
+		sql, args = exportJoinSql, append(args, conn.simId(simid))
+	}
+
+	rows, err := conn.Query(sql, args...)
+	if err != nil {
+		return fmt.Errorf("simid %v: exporting inventories: %w", simid, err)
+	}
+	defer rows.Close()
+
+	r := &ExportRow{}
+	for rows.Next() {
+		dsts := []interface{}{&r.ResId, &r.OwnerId, &r.StartTime, &r.EndTime, &r.EndReason}
+		if join {
+			dsts = append(dsts, &r.Prototype, &r.Quantity)
+		}
+		if err := rows.Scan(dsts...); err != nil {
+			return fmt.Errorf("simid %v: exporting inventories: %w", simid, err)
+		}
+		if err := fn(r); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("simid %v: exporting inventories: %w", simid, err)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package inv
+
+import (
+	"fmt"
+	"sort"
+)
+
+var (
+	flowsTableSql = "CREATE TABLE IF NOT EXISTS Flows (SimID TEXT,TransactionID INTEGER,SenderID INTEGER,ReceiverID INTEGER,Commodity TEXT,Time INTEGER,Quantity REAL);"
+	flowsDumpSql  = "INSERT INTO Flows VALUES (?,?,?,?,?,?,?);"
+	flowsSql      = `SELECT TransactionID,SenderID,ReceiverID,Commodity,Time,Quantity FROM Flows
+				  WHERE SimID = ? ORDER BY Time ASC, TransactionID ASC;`
+)
+
+// Flow is the total quantity of material transferred by a single
+// transaction.
+type Flow struct {
+	TransactionID int
+	SenderID      int
+	ReceiverID    int
+	Commodity     string
+	Time          int
+	Quantity      float64
+}
+
+// addFlow accumulates qty of a resource transacted in transaction tx into
+// the walker's flows.
+func (c *Context) addFlow(tx, sender, receiver int, commodity string, t int, qty float64) {
+	f, ok := c.flows[tx]
+	if !ok {
+		f = &Flow{TransactionID: tx, SenderID: sender, ReceiverID: receiver, Commodity: commodity, Time: t}
+		c.flows[tx] = f
+	}
+	f.Quantity += qty
+}
+
+// dumpFlows writes all accumulated flows to the Flows table in transaction
+// order.
+func (c *Context) dumpFlows() error {
+	if !c.Flows {
+		return nil
+	}
+
+	c.logf(Verbose, "    Dumping flows (%d transactions)...", len(c.flows))
+	flows := make([]*Flow, 0, len(c.flows))
+	for _, f := range c.flows {
+		flows = append(flows, f)
+	}
+	sort.Slice(flows, func(i, j int) bool { return flows[i].TransactionID < flows[j].TransactionID })
+
+	return c.sink.WriteFlows(c.Simid, flows)
+}
+
+// insertFlows writes flows as rows of the Flows table for simid within a
+// single transaction on conn.
+func insertFlows(conn *Conn, simid string, flows []*Flow) error {
+	stmt, err := conn.Prepare(flowsDumpSql)
+	if err != nil {
+		return fmt.Errorf("preparing flow insert: %w", err)
+	}
+	defer stmt.Close()
+
+	if err := conn.Exec("BEGIN TRANSACTION;"); err != nil {
+		return fmt.Errorf("dumping flows: %w", err)
+	}
+	for _, f := range flows {
+		if _, err := stmt.Exec(simid, f.TransactionID, f.SenderID, f.ReceiverID, f.Commodity, f.Time, f.Quantity); err != nil {
+			conn.Exec("ROLLBACK TRANSACTION;")
+			return fmt.Errorf("transaction %v: dumping flows: %w", f.TransactionID, err)
+		}
+	}
+	if err := conn.Exec("END TRANSACTION;"); err != nil {
+		return fmt.Errorf("dumping flows: %w", err)
+	}
+	return nil
+}
+
+// GetFlows returns all material flows recorded for simulation simid ordered
+// by time.  The simulation must have been walked with flows enabled.
+func GetFlows(conn *Conn, simid string) (flows []*Flow, err error) {
+	rows, err := conn.Query(flowsSql, simid)
+	if err != nil {
+		return nil, fmt.Errorf("simid %v: retrieving flows: %w", simid, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		f := &Flow{}
+		if err := rows.Scan(&f.TransactionID, &f.SenderID, &f.ReceiverID, &f.Commodity, &f.Time, &f.Quantity); err != nil {
+			return nil, fmt.Errorf("simid %v: retrieving flows: %w", simid, err)
+		}
+		flows = append(flows, f)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("simid %v: retrieving flows: %w", simid, err)
+	}
+	return flows, nil
+}
@@ -0,0 +1,413 @@
+package inv
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// hdf5Signature begins the superblock of every HDF5 file.
+const hdf5Signature = "\x89HDF\r\n\x1a\n"
+
+// hdf5Tables are the cyclus tables read from HDF5 files, named as in
+// Schema0.  All but AgentDeaths must be present.
+var hdf5Tables = []string{
+	"SimulationTimeInfo",
+	"Resources",
+	"ResCreators",
+	"Transactions",
+	"TransactedResources",
+	"AgentDeaths",
+}
+
+// IsHDF5 reports whether the file at path is an HDF5 file (as written by
+// the cyclus HDF5 backend) rather than a database, judging by its signature.
+// The signature is looked for at the start of the file and after each
+// possible user block, i.e. at 512, 1024, 2048... bytes.
+func IsHDF5(path string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	sig := make([]byte, len(hdf5Signature))
+	for off := int64(0); ; {
+		if _, err := f.ReadAt(sig, off); err == io.EOF {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		} else if string(sig) == hdf5Signature {
+			return true, nil
+		}
+		if off == 0 {
+			off = 512
+		} else {
+			off *= 2
+		}
+	}
+}
+
+// HDF5Input holds the cyclus tables of an HDF5 output file.  Since an HDF5
+// file can't be queried, the tables needed for walking are read into memory
+// whole and each simulation is walked from a MemStore built from them.
+type HDF5Input struct {
+	tables map[string]*h5Table
+	schema Schema
+}
+
+// OpenHDF5 reads the resource, transaction and simulation tables of the
+// cyclus HDF5 output file at path, which may be of any Schema.  Reading
+// HDF5 requires the HDF5 C library; without it (i.e. unless built with the
+// hdf5 tag) an error is returned.
+func OpenHDF5(path string) (*HDF5Input, error) {
+	var names []string
+	seen := map[string]bool{}
+	for _, s := range []Schema{Schema0, Schema1} {
+		for _, name := range hdf5Tables {
+			if name = s.Name(name); !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	tables, err := readH5Tables(path, names...)
+	if err != nil {
+		return nil, fmt.Errorf("reading %v: %w", path, err)
+	}
+	return newHDF5Input(tables)
+}
+
+// newHDF5Input detects the schema of tables, which are keyed by their names
+// in the file.
+func newHDF5Input(tables map[string]*h5Table) (*HDF5Input, error) {
+	in := &HDF5Input{tables: tables}
+	if _, ok := tables[Schema1.Name("SimulationTimeInfo")]; ok {
+		in.schema = Schema1
+	}
+	for _, name := range hdf5Tables {
+		if _, ok := tables[in.schema.Name(name)]; !ok && name != "AgentDeaths" {
+			return nil, fmt.Errorf("no %v table", in.schema.Name(name))
+		}
+	}
+	return in, nil
+}
+
+// table returns the table and columns of the file with the given Schema0
+// names.
+func (in *HDF5Input) table(name string, cols ...string) (*h5Table, []h5Field, error) {
+	t := in.tables[in.schema.Name(name)]
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = in.schema.Name(name + "." + col)
+	}
+	fields, err := t.columns(names...)
+	return t, fields, err
+}
+
+// SimIds returns the ids of the simulations in the file.
+func (in *HDF5Input) SimIds() ([]string, error) {
+	t, cols, err := in.table("SimulationTimeInfo", "SimID")
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	seen := map[string]bool{}
+	for i := 0; i < t.Len(); i++ {
+		id := cols[0].str(t.rec(i))
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// Store builds a store holding the resource graph, ownership changes and
+// agent decommissionings of the simulation simid, ready to be used as the
+// Source of a Context walking it.  Resource units and types are included if
+// b.Quantities is set and transaction commodities if b.Flows is.  Only
+// fixed length strings can be read, while cyclus writes strings of no
+// declared length (such as units, types and commodities usually are) as
+// digests of values kept in a separate dataset; an error is returned if such
+// a column is needed.
+func (in *HDF5Input) Store(simid string, b Build) (*MemStore, error) {
+	dur, err := in.duration(simid)
+	if err != nil {
+		return nil, fmt.Errorf("simid %v: %w", simid, err)
+	}
+	s := NewMemStore(dur)
+	if err := in.addResources(s, simid, b.Quantities); err != nil {
+		return nil, fmt.Errorf("simid %v: loading resources: %w", simid, err)
+	}
+	if err := in.addTransfers(s, simid, b.Flows); err != nil {
+		return nil, fmt.Errorf("simid %v: loading transactions: %w", simid, err)
+	}
+	if err := in.addDeaths(s, simid); err != nil {
+		return nil, fmt.Errorf("simid %v: loading agent deaths: %w", simid, err)
+	}
+	return s, nil
+}
+
+func (in *HDF5Input) duration(simid string) (int, error) {
+	t, cols, err := in.table("SimulationTimeInfo", "SimID", "Duration")
+	if err != nil {
+		return 0, err
+	}
+	for i := 0; i < t.Len(); i++ {
+		if rec := t.rec(i); cols[0].str(rec) == simid {
+			return cols[1].int(rec), nil
+		}
+	}
+	return 0, errors.New("no simulation time info")
+}
+
+// addResources adds the simulation's resources to s, as roots if they have
+// a creator and as children of their parents otherwise.  Their units and
+// types are only read if qty is true.
+func (in *HDF5Input) addResources(s *MemStore, simid string, qty bool) error {
+	t, cols, err := in.table("ResCreators", "SimID", "ResID", "ModelID")
+	if err != nil {
+		return err
+	}
+	creators := map[int]int{}
+	for i := 0; i < t.Len(); i++ {
+		if rec := t.rec(i); cols[0].str(rec) == simid {
+			creators[cols[1].int(rec)] = cols[2].int(rec)
+		}
+	}
+
+	t, cols, err = in.table("Resources", "SimID", "ID", "TimeCreated", "Parent1", "Parent2", "Quantity", "units", "Type")
+	if err != nil {
+		return err
+	}
+	if qty {
+		if err := in.checkStrings("Resources", cols[6:], "units", "Type"); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < t.Len(); i++ {
+		rec := t.rec(i)
+		if cols[0].str(rec) != simid {
+			continue
+		}
+		r := memRes{
+			id:   cols[1].int(rec),
+			time: cols[2].int(rec),
+			qty:  cols[5].float(rec),
+		}
+		if qty {
+			r.units, r.typ = cols[6].str(rec), cols[7].str(rec)
+		}
+		if creator, ok := creators[r.id]; ok {
+			s.roots = append(s.roots, Node{
+				ResId:     r.id,
+				OwnerId:   creator,
+				StartTime: r.time,
+				EndTime:   math.MaxInt32,
+				Quantity:  r.qty,
+				Units:     r.units,
+				Type:      r.typ,
+			})
+		}
+		p1, p2 := cols[3].int(rec), cols[4].int(rec)
+		if p1 != 0 {
+			s.graph.children[p1] = append(s.graph.children[p1], r)
+		}
+		if p2 != 0 && p2 != p1 {
+			s.graph.children[p2] = append(s.graph.children[p2], r)
+		}
+	}
+	return nil
+}
+
+// addTransfers adds the ownership changes of the simulation's resources to
+// s.  Their commodities are only read if flows is true.
+func (in *HDF5Input) addTransfers(s *MemStore, simid string, flows bool) error {
+	t, cols, err := in.table("Transactions", "SimID", "ID", "SenderID", "ReceiverID", "Time", "Commodity")
+	if err != nil {
+		return err
+	}
+	if flows {
+		if err := in.checkStrings("Transactions", cols[5:], "Commodity"); err != nil {
+			return err
+		}
+	}
+	txs := map[int]OwnerChange{}
+	for i := 0; i < t.Len(); i++ {
+		rec := t.rec(i)
+		if cols[0].str(rec) != simid {
+			continue
+		}
+		o := OwnerChange{
+			Tx:       cols[1].int(rec),
+			Sender:   cols[2].int(rec),
+			Receiver: cols[3].int(rec),
+			Time:     cols[4].int(rec),
+		}
+		if flows {
+			o.Commodity = cols[5].str(rec)
+		}
+		txs[o.Tx] = o
+	}
+
+	t, cols, err = in.table("TransactedResources", "SimID", "TransactionID", "ResourceID")
+	if err != nil {
+		return err
+	}
+	for i := 0; i < t.Len(); i++ {
+		rec := t.rec(i)
+		if cols[0].str(rec) != simid {
+			continue
+		}
+		if o, ok := txs[cols[1].int(rec)]; ok {
+			s.AddTransfer(cols[2].int(rec), o.Tx, o.Time, o.Sender, o.Receiver, o.Commodity)
+		}
+	}
+	return nil
+}
+
+// checkStrings returns an error unless the columns cols of table name, given
+// by their Schema0 names, hold fixed length strings.
+func (in *HDF5Input) checkStrings(name string, cols []h5Field, names ...string) error {
+	for i, f := range cols {
+		if f.class != h5String {
+			return fmt.Errorf("%v column %v: only fixed length strings can be read", in.schema.Name(name), in.schema.Name(name+"."+names[i]))
+		}
+	}
+	return nil
+}
+
+func (in *HDF5Input) addDeaths(s *MemStore, simid string) error {
+	if _, ok := in.tables[in.schema.Name("AgentDeaths")]; !ok {
+		return nil
+	}
+	t, cols, err := in.table("AgentDeaths", "SimID", "AgentID", "DeathDate")
+	if err != nil {
+		return err
+	}
+	for i := 0; i < t.Len(); i++ {
+		if rec := t.rec(i); cols[0].str(rec) == simid {
+			s.AddDeath(cols[1].int(rec), cols[2].int(rec))
+		}
+	}
+	return nil
+}
+
+// h5Class is the kind of value held by a member of an HDF5 compound
+// datatype, as far as decoding cyclus tables is concerned.
+type h5Class int
+
+const (
+	h5Int h5Class = iota
+	h5Float
+	h5String // fixed length, NUL padded
+	h5UUID   // 16 byte opaque, as cyclus writes simulation ids
+	h5Other  // variable length strings, digests and other unsupported values
+)
+
+// h5Field locates a member of the compound datatype of a cyclus HDF5 table
+// within each of the table's records.
+type h5Field struct {
+	class  h5Class
+	offset int
+	size   int
+}
+
+// h5Table holds the records of a cyclus HDF5 table (a one dimensional
+// dataset of a compound datatype) exactly as they are laid out in the file.
+// Numbers are assumed to be little endian.
+type h5Table struct {
+	// fields are the members of the table's datatype keyed by their lower
+	// case names.
+	fields map[string]h5Field
+	// size is the size in bytes of each record.
+	size int
+	data []byte
+}
+
+// Len returns the number of records in the table.
+func (t *h5Table) Len() int { return len(t.data) / t.size }
+
+// rec returns the bytes of record i.
+func (t *h5Table) rec(i int) []byte { return t.data[i*t.size : (i+1)*t.size] }
+
+// columns returns the fields of the table with the given names, which are
+// matched case insensitively.
+func (t *h5Table) columns(names ...string) ([]h5Field, error) {
+	cols := make([]h5Field, len(names))
+	for i, name := range names {
+		f, ok := t.fields[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("no %v column", name)
+		}
+		cols[i] = f
+	}
+	return cols, nil
+}
+
+// int returns the field's value in rec as an integer.  Other values
+// decode as zero.
+func (f h5Field) int(rec []byte) int {
+	b := rec[f.offset : f.offset+f.size]
+	switch {
+	case f.class == h5Float:
+		return int(f.float(rec))
+	case f.class != h5Int:
+		return 0
+	case f.size == 1:
+		return int(int8(b[0]))
+	case f.size == 2:
+		return int(int16(binary.LittleEndian.Uint16(b)))
+	case f.size == 4:
+		return int(int32(binary.LittleEndian.Uint32(b)))
+	case f.size == 8:
+		return int(int64(binary.LittleEndian.Uint64(b)))
+	}
+	return 0
+}
+
+// float returns the field's value in rec as a float.  Other values decode
+// as zero.
+func (f h5Field) float(rec []byte) float64 {
+	b := rec[f.offset : f.offset+f.size]
+	switch {
+	case f.class == h5Int:
+		return float64(f.int(rec))
+	case f.class != h5Float:
+		return 0
+	case f.size == 4:
+		return float64(math.Float32frombits(binary.LittleEndian.Uint32(b)))
+	case f.size == 8:
+		return math.Float64frombits(binary.LittleEndian.Uint64(b))
+	}
+	return 0
+}
+
+// str returns the field's value in rec as a string.  UUIDs are formatted
+// in their canonical hyphenated form.  Variable length strings and other
+// values decode as empty, so string columns must be checked with
+// checkStrings before they are read.
+func (f h5Field) str(rec []byte) string {
+	b := rec[f.offset : f.offset+f.size]
+	switch f.class {
+	case h5String:
+		if i := strings.IndexByte(string(b), 0); i >= 0 {
+			b = b[:i]
+		}
+		return string(b)
+	case h5UUID:
+		return formatUUID(b)
+	case h5Int:
+		return strconv.Itoa(f.int(rec))
+	case h5Float:
+		return strconv.FormatFloat(f.float(rec), 'g', -1, 64)
+	}
+	return ""
+}
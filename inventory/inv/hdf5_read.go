@@ -0,0 +1,93 @@
+//go:build hdf5
+// +build hdf5
+
+package inv
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gonum.org/v1/hdf5"
+)
+
+// readH5Tables reads the named tables from the HDF5 file at path.  Tables
+// missing from the file are left out of the returned map.
+func readH5Tables(path string, names ...string) (map[string]*h5Table, error) {
+	f, err := hdf5.OpenFile(path, hdf5.F_ACC_RDONLY)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	tables := map[string]*h5Table{}
+	for _, name := range names {
+		if !f.LinkExists(name) {
+			continue
+		}
+		t, err := readH5Table(f, name)
+		if err != nil {
+			return nil, fmt.Errorf("reading %v table: %w", name, err)
+		}
+		tables[name] = t
+	}
+	return tables, nil
+}
+
+// readH5Table reads the records of the table dataset name undecoded, using
+// the dataset's own datatype as the memory datatype.
+func readH5Table(f *hdf5.File, name string) (*h5Table, error) {
+	ds, err := f.OpenDataset(name)
+	if err != nil {
+		return nil, err
+	}
+	defer ds.Close()
+
+	dtype, err := ds.Datatype()
+	if err != nil {
+		return nil, err
+	}
+	defer dtype.Close()
+	if dtype.Class() != hdf5.T_COMPOUND {
+		return nil, fmt.Errorf("datatype is %v, not compound", dtype.Class())
+	}
+
+	ct := &hdf5.CompoundType{Datatype: *dtype}
+	t := &h5Table{fields: map[string]h5Field{}, size: int(dtype.Size())}
+	for i := 0; i < ct.NMembers(); i++ {
+		mtype, err := ct.MemberType(i)
+		if err != nil {
+			return nil, err
+		}
+		f := h5Field{class: h5Other, offset: ct.MemberOffset(i), size: int(mtype.Size())}
+		switch mtype.Class() {
+		case hdf5.T_INTEGER:
+			f.class = h5Int
+		case hdf5.T_FLOAT:
+			f.class = h5Float
+		case hdf5.T_STRING:
+			if vl := (&hdf5.VarLenType{Datatype: *mtype}); !vl.IsVariableStr() {
+				f.class = h5String
+			}
+		case hdf5.T_OPAQUE:
+			if f.size == 16 {
+				f.class = h5UUID
+			}
+		}
+		mtype.Close()
+		t.fields[strings.ToLower(ct.MemberName(i))] = f
+	}
+
+	space := ds.Space()
+	if space == nil {
+		return nil, errors.New("no dataspace")
+	}
+	defer space.Close()
+	if n := space.SimpleExtentNPoints(); n > 0 {
+		t.data = make([]byte, n*t.size)
+		if err := ds.Read(&t.data); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
@@ -0,0 +1,80 @@
+//go:build hdf5
+// +build hdf5
+
+package inv
+
+import (
+	"sort"
+	"testing"
+)
+
+// TestReadHDF5 reads and walks testdata/cyclus1.h5, a small file laid out as
+// the cyclus 1.x HDF5 backend lays out its output: simulation ids are 16 byte
+// opaque values and string columns are variable length, stored as arrays of
+// SHA1 digests into the file's value tables.  The file was generated to that
+// layout rather than written by cyclus itself.  In it, resource 1 is created
+// by agent 10, transferred to agent 20 and then split into 2 and 3, the
+// latter of which is transferred on to agent 30.  Agent 20 is decommissioned
+// before the simulation ends.
+//
+// The units, types and commodities are digests, so only a walk that needs
+// none of them succeeds.
+func TestReadHDF5(t *testing.T) {
+	const path = "testdata/cyclus1.h5"
+	if ok, err := IsHDF5(path); err != nil {
+		t.Fatal(err)
+	} else if !ok {
+		t.Fatalf("expected %v to be detected as hdf5", path)
+	}
+
+	in, err := OpenHDF5(path)
+	if err != nil {
+		t.Fatal(err)
+	} else if in.schema != Schema1 {
+		t.Errorf("expected schema %v, got %v", Schema1, in.schema)
+	}
+	simids, err := in.SimIds()
+	if err != nil {
+		t.Fatal(err)
+	} else if len(simids) != 1 || simids[0] != "3d0c1a6e-7b2f-4c49-9e1a-5f8d2b6c4a10" {
+		t.Fatalf("expected simid 3d0c1a6e-7b2f-4c49-9e1a-5f8d2b6c4a10, got %v", simids)
+	}
+
+	for _, b := range []Build{{Quantities: true}, {Flows: true}} {
+		if _, err := in.Store(simids[0], b); err == nil {
+			t.Errorf("%+v: expected an error reading digest string columns", b)
+		}
+	}
+
+	s, err := in.Store(simids[0], Build{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := &Context{Simid: simids[0], Source: s, Sink: s}
+	if err := ctx.WalkAll(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Node{
+		{ResId: 1, OwnerId: 10, StartTime: 0, EndTime: 2, EndReason: EndTransfer, Quantity: 10},
+		{ResId: 1, OwnerId: 20, StartTime: 2, EndTime: 3, EndReason: EndSplit, Quantity: 10},
+		{ResId: 2, OwnerId: 20, StartTime: 3, EndTime: 6, EndReason: EndDied, Quantity: 5},
+		{ResId: 3, OwnerId: 20, StartTime: 3, EndTime: 4, EndReason: EndTransfer, Quantity: 5},
+		{ResId: 3, OwnerId: 30, StartTime: 4, EndTime: 10, EndReason: EndSimEnd, Quantity: 5},
+	}
+	got := s.Intervals
+	sort.Slice(got, func(i, j int) bool {
+		if got[i].ResId != got[j].ResId {
+			return got[i].ResId < got[j].ResId
+		}
+		return got[i].StartTime < got[j].StartTime
+	})
+	if len(got) != len(want) {
+		t.Fatalf("expected %v intervals, got %v: %+v", len(want), len(got), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("interval %v: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+}
@@ -0,0 +1,13 @@
+//go:build !hdf5
+// +build !hdf5
+
+package inv
+
+import "errors"
+
+// errNoHDF5 is returned when reading HDF5 files without the HDF5 C library.
+var errNoHDF5 = errors.New("hdf5 support not built in (rebuild with -tags hdf5)")
+
+func readH5Tables(path string, names ...string) (map[string]*h5Table, error) {
+	return nil, errNoHDF5
+}
@@ -0,0 +1,187 @@
+package inv
+
+import (
+	"fmt"
+	"strings"
+)
+
+var (
+	// inputIndexes are the indexes (table followed by columns, named as in
+	// Schema0) created on the cyclus tables read while walking.
+	inputIndexes = [][]string{
+		{"Resources", "SimID", "ID"},
+		{"Resources", "Parent1"},
+		{"Resources", "Parent2"},
+		{"Resources", "StateID"},
+		{"Compositions", "ID"},
+		{"Compositions", "IsoID"},
+		{"Transactions", "ID"},
+		{"Transactions", "Time"},
+		{"Transactions", "ReceiverID"},
+		{"TransactedResources", "TransactionID"},
+		{"TransactedResources", "ResourceID"},
+		{"ResCreators", "SimID", "ResID"},
+		{"Agents", "Prototype"},
+		{"Agents", "ID"},
+	}
+
+	// tmpIndexTableSql records the indexes created by PrepareWith that
+	// Finish must drop.  It persists so an interrupted run's temporary
+	// indexes are still dropped by the next Finish, and is only created when
+	// indexing temporarily.
+	tmpIndexTableSql = "CREATE TABLE IF NOT EXISTS InventoryTmpIndexes (Name TEXT);"
+	hasIndexSql      = "SELECT COUNT(*) FROM sqlite_master WHERE type = 'index' AND name = ?;"
+	usedBytesSql     = "SELECT (pc.page_count - fc.freelist_count) * ps.page_size FROM pragma_page_count() AS pc, pragma_freelist_count() AS fc, pragma_page_size() AS ps;"
+)
+
+// IndexOptions controls how PrepareWith indexes the cyclus tables read
+// while walking.  The zero value creates the indexes and keeps them.
+type IndexOptions struct {
+	// Skip causes no indexes to be created on the cyclus tables.  Walking
+	// without InMemory set is then very slow for large simulations.
+	Skip bool
+	// Temporary causes the indexes created to be dropped again by Finish,
+	// leaving the database no larger than it was.  Indexes that already
+	// existed are left alone.
+	Temporary bool
+	// Report causes the disk space taken by each index created to be
+	// printed.
+	Report bool
+}
+
+// createIndexes creates the indexes on the cyclus tables as directed by
+// opts.  Indexes on tables missing from the database are skipped; any other
+// failure is returned.
+func createIndexes(conn *Conn, opts IndexOptions) error {
+	if opts.Skip {
+		Log.Logf(Info, "Skipping cyclus table indexes...")
+		return nil
+	} else if attached, err := inputAttached(conn); err != nil {
+		return err
+	} else if attached {
+		Log.Logf(Info, "Leaving attached input database unindexed...")
+		return nil
+	}
+
+	Log.Logf(Info, "Creating cyclus table indexes...")
+	if opts.Temporary {
+		if err := conn.Exec(tmpIndexTableSql); err != nil {
+			return fmt.Errorf("creating temporary index table: %w", err)
+		}
+	}
+	var total int64
+	done := map[string]bool{}
+	for _, idx := range inputIndexes {
+		table, cols := conn.Schema.Name(idx[0]), make([]string, len(idx)-1)
+		for i, col := range idx[1:] {
+			cols[i] = conn.Schema.Name(idx[0] + "." + col)
+		}
+		// schemas may map two indexes onto the same table and columns
+		name := indexName(table, cols...)
+		if done[strings.ToLower(name)] {
+			continue
+		}
+		done[strings.ToLower(name)] = true
+
+		if ok, err := hasTable(conn, table); err != nil {
+			return err
+		} else if !ok {
+			Log.Logf(Verbose, "    skipping index %v: no table %v", name, table)
+			continue
+		}
+		if ok, err := hasIndex(conn, name); err != nil {
+			return err
+		} else if ok {
+			continue
+		}
+
+		before, err := usedBytes(conn)
+		if err != nil {
+			return err
+		}
+		if err := conn.Exec(Index(table, cols...)); err != nil {
+			return fmt.Errorf("creating index %v: %w", name, err)
+		}
+		if opts.Temporary {
+			if err := conn.Exec("INSERT INTO InventoryTmpIndexes VALUES (?);", name); err != nil {
+				return fmt.Errorf("recording temporary index %v: %w", name, err)
+			}
+		}
+
+		if opts.Report {
+			after, err := usedBytes(conn)
+			if err != nil {
+				return err
+			}
+			Log.Logf(Info, "    index %v: %v bytes", name, after-before)
+			total += after - before
+		}
+	}
+
+	if opts.Report {
+		Log.Logf(Info, "Cyclus table indexes created use %v bytes", total)
+	}
+	return nil
+}
+
+// dropTmpIndexes drops the temporary indexes recorded by createIndexes.
+func dropTmpIndexes(conn *Conn) error {
+	if ok, err := hasTable(conn, "InventoryTmpIndexes"); err != nil || !ok {
+		return err
+	}
+
+	var names []string
+	rows, err := conn.Query("SELECT Name FROM InventoryTmpIndexes;")
+	if err != nil {
+		return fmt.Errorf("retrieving temporary indexes: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return fmt.Errorf("retrieving temporary indexes: %w", err)
+		}
+		names = append(names, name)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("retrieving temporary indexes: %w", err)
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	Log.Logf(Info, "Dropping temporary cyclus table indexes...")
+	for _, name := range names {
+		if err := conn.Exec("DROP INDEX IF EXISTS " + name + ";"); err != nil {
+			return fmt.Errorf("dropping index %v: %w", name, err)
+		}
+	}
+	return conn.Exec("DELETE FROM InventoryTmpIndexes;")
+}
+
+// hasIndex reports whether the database for conn has an index named name.
+func hasIndex(conn *Conn, name string) (bool, error) {
+	sql := hasIndexSql
+	if conn.Dialect == Postgres {
+		sql = pgHasIndexSql
+	}
+	n := 0
+	if err := conn.QueryRow(sql, name).Scan(&n); err != nil {
+		return false, fmt.Errorf("looking up index %v: %w", name, err)
+	}
+	return n > 0, nil
+}
+
+// usedBytes returns the disk space used by the database for conn, excluding
+// free pages.
+func usedBytes(conn *Conn) (int64, error) {
+	sql := usedBytesSql
+	if conn.Dialect == Postgres {
+		sql = pgUsedBytesSql
+	}
+	var n int64
+	if err := conn.QueryRow(sql).Scan(&n); err != nil {
+		return 0, fmt.Errorf("measuring database size: %w", err)
+	}
+	return n, nil
+}
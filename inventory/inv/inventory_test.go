@@ -0,0 +1,2273 @@
+package inv
+
+import (
+	"bytes"
+	sqldb "database/sql"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const tmpDbFile = "/tmp/cyclus_inv_test_db.sqlite"
+
+func TestRegression1(t *testing.T) {
+	if err := os.RemoveAll(tmpDbFile); err != nil {
+		t.Fatal(err)
+	}
+
+	conn, err := Open("sqlite3", tmpDbFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, sql := range rawSimSql {
+		if err := conn.Exec(sql); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := Prepare(conn); err != nil {
+		t.Fatal(err)
+	}
+
+	simids, err := GetSimIds(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sqls []string
+	record := func(e Event) {
+		if e.Kind == Interval {
+			sqls = append(sqls, e.SQL())
+		}
+	}
+	for _, simid := range simids {
+		ctx := NewContext(conn, simid, record)
+		ctx.Quantities = true
+		if err := ctx.WalkAll(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	i := 0
+	for _, sql := range sqls {
+		if sql != inventorySql[i] {
+			t.Errorf("[node %v] expected \"%s\", got \"%s\"", i, inventorySql[i], sql)
+		}
+		i++
+	}
+	checkQuantities(t, conn, len(inventorySql))
+
+	if err := Finish(conn); err != nil {
+		t.Fatal(err)
+	}
+
+}
+
+func TestInMemory(t *testing.T) {
+	conn := openTestDb(t)
+	defer conn.Close()
+
+	simids, err := GetSimIds(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sqls []string
+	record := func(e Event) {
+		if e.Kind == Interval {
+			sqls = append(sqls, e.SQL())
+		}
+	}
+	for _, simid := range simids {
+		ctx := NewContext(conn, simid, record)
+		ctx.InMemory = true
+		ctx.Quantities = true
+		if err := ctx.WalkAll(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	i := 0
+	for _, sql := range sqls {
+		if sql != inventorySql[i] {
+			t.Errorf("[node %v] expected \"%s\", got \"%s\"", i, inventorySql[i], sql)
+		}
+		i++
+	}
+	if i != len(inventorySql) {
+		t.Errorf("expected %v inventory rows, got %v", len(inventorySql), i)
+	}
+	checkQuantities(t, conn, len(inventorySql))
+}
+
+func TestWalkAllError(t *testing.T) {
+	conn := openTestDb(t)
+	defer conn.Close()
+
+	if err := conn.Exec("DROP TABLE TransactedResources"); err != nil {
+		t.Fatal(err)
+	}
+
+	simids, err := GetSimIds(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// every simulation should fail independently without poisoning the
+	// connection for the others
+	for _, simid := range simids {
+		ctx := NewContext(conn, simid, nil)
+		err := ctx.WalkAll()
+		if err == nil {
+			t.Fatalf("simid %v: expected error, got nil", simid)
+		} else if !strings.Contains(err.Error(), simid) {
+			t.Errorf("error %q does not identify simid %v", err, simid)
+		}
+	}
+}
+
+// TestDeepChain walks a synthetic simulation with a single root resource
+// that is split a million times in sequence, i.e. one million resources deep.
+func TestDeepChain(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping deep chain walk in short mode")
+	}
+
+	const n = 1000000
+	const simid = "deep-chain"
+
+	if err := os.RemoveAll(tmpDbFile); err != nil {
+		t.Fatal(err)
+	}
+
+	conn, err := Open("sqlite3", tmpDbFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if err := writeChain(conn, simid, n); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Prepare(conn); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := NewContext(conn, simid, nil)
+	if err := ctx.WalkAll(); err != nil {
+		t.Fatal(err)
+	}
+
+	var count, min, max int
+	row := conn.QueryRow("SELECT COUNT(*),MIN(ResID),MAX(ResID) FROM Inventories WHERE SimID = ?", simid)
+	if err := row.Scan(&count, &min, &max); err != nil {
+		t.Fatal(err)
+	}
+
+	if count != n || min != 1 || max != n {
+		t.Errorf("expected %v rows for resids 1-%v, got %v rows for resids %v-%v", n, n, count, min, max)
+	}
+
+	var agent, start, end int
+	row = conn.QueryRow("SELECT AgentID,StartTime,EndTime FROM Inventories WHERE SimID = ? AND ResID = ?", simid, n)
+	if err := row.Scan(&agent, &start, &end); err != nil {
+		t.Fatal(err)
+	}
+
+	if agent != 1 || start != n-1 || end != n {
+		t.Errorf("leaf resource: expected (1, %v, %v), got (%v, %v, %v)", n-1, n, agent, start, end)
+	}
+}
+
+func TestWalkAllParallel(t *testing.T) {
+	conn := openTestDb(t)
+	defer conn.Close()
+
+	simids, err := GetSimIds(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, simid := range simids {
+		ctx := NewContext(conn, simid, nil)
+		if err := ctx.WalkAll(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := inventoryRows(t, conn)
+
+	for _, njobs := range []int{2, 4} {
+		if err := Clear(conn, simids...); err != nil {
+			t.Fatal(err)
+		}
+
+		w, err := NewWriter(conn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		open := func() (*Conn, error) { return Open("sqlite3", tmpDbFile) }
+		err = WalkAllParallel(open, w, simids, njobs, nil)
+		if cerr := w.Close(); cerr != nil {
+			t.Fatal(cerr)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got := inventoryRows(t, conn)
+		if len(got) != len(want) {
+			t.Fatalf("-j %v: expected %v rows, got %v", njobs, len(want), len(got))
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("-j %v: [row %v] expected %v, got %v", njobs, i, want[i], got[i])
+			}
+		}
+	}
+}
+
+func TestRebuildSubset(t *testing.T) {
+	conn := openTestDb(t)
+	defer conn.Close()
+
+	simids, err := GetSimIds(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, simid := range simids {
+		ctx := NewContext(conn, simid, nil)
+		if err := ctx.WalkAll(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := inventoryRows(t, conn)
+
+	// rebuilding a single simulation must not disturb the others' rows
+	if err := Prepare(conn); err != nil {
+		t.Fatal(err)
+	}
+	subset, err := FilterSimIds(simids, simids[1][:8])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Clear(conn, subset...); err != nil {
+		t.Fatal(err)
+	}
+	for _, simid := range subset {
+		ctx := NewContext(conn, simid, nil)
+		if err := ctx.WalkAll(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got := inventoryRows(t, conn)
+	if len(got) != len(want) {
+		t.Fatalf("expected %v rows, got %v", len(want), len(got))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("[row %v] expected %v, got %v", i, want[i], got[i])
+		}
+	}
+}
+
+func TestPending(t *testing.T) {
+	conn := openTestDb(t)
+	defer conn.Close()
+
+	simids, err := GetSimIds(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pending, err := Pending(conn, simids, Build{})
+	if err != nil {
+		t.Fatal(err)
+	} else if len(pending) != len(simids) {
+		t.Fatalf("fresh db: expected %v pending, got %v", simids, pending)
+	}
+
+	if err := Clear(conn, simids...); err != nil {
+		t.Fatal(err)
+	}
+	for _, simid := range simids[:2] {
+		ctx := NewContext(conn, simid, nil)
+		if err := ctx.WalkAll(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the third simulation was cleared but never walked
+	pending, err = Pending(conn, simids, Build{})
+	if err != nil {
+		t.Fatal(err)
+	} else if len(pending) != 1 || pending[0] != simids[2] {
+		t.Errorf("partial build: expected [%v] pending, got %v", simids[2], pending)
+	}
+
+	// inventories built by another version are stale
+	if err := conn.Exec("UPDATE InventoryStatus SET Version = 'old' WHERE SimID = ?", simids[0]); err != nil {
+		t.Fatal(err)
+	}
+	pending, err = Pending(conn, simids, Build{})
+	if err != nil {
+		t.Fatal(err)
+	} else if len(pending) != 2 || pending[0] != simids[0] || pending[1] != simids[2] {
+		t.Errorf("stale version: expected [%v %v] pending, got %v", simids[0], simids[2], pending)
+	}
+}
+
+func TestPendingBuild(t *testing.T) {
+	conn := openTestDb(t)
+	defer conn.Close()
+
+	simids, err := GetSimIds(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	simid := simids[0]
+
+	// building nuclides needs a Compositions table, though it may be empty
+	if err := conn.Exec("CREATE TABLE Compositions (SimID TEXT, ID INTEGER, IsoID INTEGER, Quantity REAL);"); err != nil {
+		t.Fatal(err)
+	}
+
+	build := func(b Build) {
+		if err := Clear(conn, simid); err != nil {
+			t.Fatal(err)
+		}
+		ctx := NewContext(conn, simid, nil)
+		ctx.Quantities, ctx.Flows = b.Quantities, b.Flows
+		if err := ctx.WalkAll(); err != nil {
+			t.Fatal(err)
+		} else if !b.Nuclides {
+			return
+		} else if err := BuildNuclides(conn, simid); err != nil {
+			t.Fatal(err)
+		}
+	}
+	isPending := func(want Build) bool {
+		pending, err := Pending(conn, []string{simid}, want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return len(pending) == 1
+	}
+
+	// a plain build lacks every optional part
+	build(Build{})
+	if isPending(Build{}) {
+		t.Errorf("plain build: expected nothing pending for a plain rebuild")
+	}
+	for _, want := range []Build{{Quantities: true}, {Flows: true}, {Nuclides: true}} {
+		if !isPending(want) {
+			t.Errorf("plain build: expected pending for %+v", want)
+		}
+	}
+
+	// a full build satisfies any request
+	all := Build{Quantities: true, Flows: true, Nuclides: true}
+	build(all)
+	for _, want := range []Build{{}, {Quantities: true}, {Flows: true}, {Nuclides: true}, all} {
+		if isPending(want) {
+			t.Errorf("full build: expected nothing pending for %+v", want)
+		}
+	}
+
+	// rebuilding without nuclides drops them
+	build(Build{Quantities: true, Flows: true})
+	if !isPending(all) {
+		t.Errorf("rebuild without nuclides: expected pending for %+v", all)
+	}
+}
+
+func TestPrepareOldTable(t *testing.T) {
+	if err := os.RemoveAll(tmpDbFile); err != nil {
+		t.Fatal(err)
+	}
+	conn, err := Open("sqlite3", tmpDbFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	for _, sql := range rawSimSql {
+		if err := conn.Exec(sql); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, sql := range []string{
+		"CREATE TABLE Inventories (SimID TEXT,ResID INTEGER,AgentID INTEGER,StartTime INTEGER,EndTime INTEGER);",
+		"CREATE TABLE InventoryStatus (SimID TEXT,Version TEXT,Completed INTEGER);",
+	} {
+		if err := conn.Exec(sql); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Prepare must bring the tables built by an older version up to date
+	for i := 0; i < 2; i++ {
+		if err := Prepare(conn); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	simids, err := GetSimIds(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, simid := range simids {
+		ctx := NewContext(conn, simid, nil)
+		ctx.Quantities = true
+		if err := ctx.WalkAll(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkQuantities(t, conn, len(inventorySql))
+
+	pending, err := Pending(conn, simids, Build{Quantities: true})
+	if err != nil {
+		t.Fatal(err)
+	} else if len(pending) != 0 {
+		t.Errorf("expected nothing pending, got %v", pending)
+	}
+}
+
+func TestSeries(t *testing.T) {
+	conn := openTestDb(t)
+	defer conn.Close()
+
+	simids, err := GetSimIds(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	simid := simids[0]
+
+	ctx := NewContext(conn, simid, nil)
+	if err := ctx.WalkAll(); err != nil {
+		t.Fatal(err)
+	}
+
+	agents, err := PrototypeAgents(conn, simid, "dairy sink")
+	if err != nil {
+		t.Fatal(err)
+	} else if fmt.Sprint(agents) != "[5 6 7 9]" {
+		t.Errorf("expected dairy sink agents [5 6 7 9], got %v", agents)
+	}
+
+	sql := `SELECT TOTAL(res.Quantity) FROM Inventories AS inv
+			INNER JOIN Resources AS res ON inv.ResID = res.ID AND inv.SimID = res.SimID
+			WHERE inv.SimID = ? AND inv.AgentID = ? AND inv.StartTime <= ? AND inv.EndTime > ?`
+	for _, agent := range []int{4, 5, 8} {
+		series, err := Series(conn, simid, agent)
+		if err != nil {
+			t.Fatal(err)
+		} else if len(series) != 25 {
+			t.Fatalf("agent %v: expected 25 timesteps, got %v", agent, len(series))
+		}
+
+		for tm, got := range series {
+			want := 0.0
+			if err := conn.QueryRow(sql, simid, agent, tm, tm).Scan(&want); err != nil {
+				t.Fatal(err)
+			}
+			if math.Abs(got-want) > 1e-9 {
+				t.Errorf("agent %v, time %v: expected quantity %v, got %v", agent, tm, want, got)
+			}
+		}
+	}
+}
+
+func TestNuclides(t *testing.T) {
+	conn := openTestDb(t)
+	defer conn.Close()
+
+	// every resource in the test data has StateID 0
+	fracs := map[int]float64{92235: 0.04, 92238: 0.95, 8016: 0.01}
+	if err := conn.Exec("CREATE TABLE Compositions (SimID TEXT, ID INTEGER, IsoID INTEGER, Quantity REAL);"); err != nil {
+		t.Fatal(err)
+	}
+	simids, err := GetSimIds(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, simid := range simids {
+		for nuc, frac := range fracs {
+			// store absolute amounts to check normalization
+			if err := conn.Exec("INSERT INTO Compositions VALUES (?,0,?,?);", simid, nuc, 10*frac); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	simid := simids[0]
+	ctx := NewContext(conn, simid, nil)
+	if err := ctx.WalkAll(); err != nil {
+		t.Fatal(err)
+	}
+	if err := BuildNuclides(conn, simid); err != nil {
+		t.Fatal(err)
+	}
+
+	n := len(inventoryRows(t, conn))
+	count := 0
+	if err := conn.QueryRow("SELECT COUNT(*) FROM InventoryNuclides WHERE SimID = ?", simid).Scan(&count); err != nil {
+		t.Fatal(err)
+	} else if count != n*len(fracs) {
+		t.Errorf("expected %v nuclide inventory rows, got %v", n*len(fracs), count)
+	}
+
+	agents := []int{5, 6, 7, 9}
+	series, err := Series(conn, simid, agents...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for tm, tot := range series {
+		masses, err := Nuclides(conn, simid, tm, agents...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for nuc, frac := range fracs {
+			if want := tot * frac; math.Abs(masses[nuc]-want) > 1e-9 {
+				t.Errorf("time %v, nuclide %v: expected mass %v, got %v", tm, nuc, want, masses[nuc])
+			}
+		}
+	}
+}
+
+func TestFlows(t *testing.T) {
+	conn := openTestDb(t)
+	defer conn.Close()
+
+	simids, err := GetSimIds(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sql := `SELECT tr.ID,tr.SenderID,tr.ReceiverID,tr.Commodity,tr.Time,TOTAL(res.Quantity)
+			FROM Transactions AS tr
+			INNER JOIN TransactedResources AS trr ON tr.ID = trr.TransactionID AND tr.SimID = trr.SimID
+			INNER JOIN Resources AS res ON res.ID = trr.ResourceID AND res.SimID = tr.SimID
+			WHERE tr.SimID = ? GROUP BY tr.ID ORDER BY tr.Time ASC, tr.ID ASC`
+
+	for _, inmem := range []bool{false, true} {
+		if err := Clear(conn, simids...); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, simid := range simids {
+			ctx := NewContext(conn, simid, nil)
+			ctx.InMemory = inmem
+			ctx.Flows = true
+			if err := ctx.WalkAll(); err != nil {
+				t.Fatal(err)
+			}
+
+			flows, err := GetFlows(conn, simid)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var want []*Flow
+			rows, err := conn.Query(sql, simid)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for rows.Next() {
+				f := &Flow{}
+				if err := rows.Scan(&f.TransactionID, &f.SenderID, &f.ReceiverID, &f.Commodity, &f.Time, &f.Quantity); err != nil {
+					t.Fatal(err)
+				}
+				want = append(want, f)
+			}
+			if err := rows.Err(); err != nil {
+				t.Fatal(err)
+			}
+
+			if len(flows) != len(want) || len(want) == 0 {
+				t.Fatalf("in-memory=%v: expected %v flows, got %v", inmem, len(want), len(flows))
+			}
+			for i := range want {
+				if *flows[i] != *want[i] {
+					t.Errorf("in-memory=%v: [flow %v] expected %+v, got %+v", inmem, i, *want[i], *flows[i])
+				}
+			}
+		}
+	}
+}
+
+func TestAgentGraph(t *testing.T) {
+	conn := openTestDb(t)
+	defer conn.Close()
+
+	g, err := AgentGraph(conn, "07947e67-0c8e-41a2-ad8e-15ecb77b4bde", 6, 0, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := g.WriteDot(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `digraph G {
+    res20 [label="Res 20\nt=6\nqty 50",shape=ellipse];
+    agent4 [label="Agent 4",shape=box];
+    res30 [label="Res 30\nt=8\nqty 25",shape=ellipse];
+    res31 [label="Res 31\nt=8\nqty 25",shape=ellipse];
+    agent4 -> res20 [label="t=7\nqty 50"];
+    res20 -> res30 [label="t=8\nqty 25"];
+    res20 -> res31 [label="t=8\nqty 25"];
+}
+`
+	if got := buf.String(); got != want {
+		t.Errorf("expected:\n%s\ngot:\n%s", want, got)
+	}
+
+	// res 20 arrived before t0 but was split after it
+	g, err = AgentGraph(conn, "07947e67-0c8e-41a2-ad8e-15ecb77b4bde", 6, 8, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf.Reset()
+	if err := g.WriteDot(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	want = `digraph G {
+    res20 [label="Res 20\nt=6\nqty 50",shape=ellipse];
+    res30 [label="Res 30\nt=8\nqty 25",shape=ellipse];
+    res31 [label="Res 31\nt=8\nqty 25",shape=ellipse];
+    res20 -> res30 [label="t=8\nqty 25"];
+    res20 -> res31 [label="t=8\nqty 25"];
+}
+`
+	if got := buf.String(); got != want {
+		t.Errorf("from t=8: expected:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestAgentGraphReentry(t *testing.T) {
+	conn := openRawDb(t, tmpDbFile)
+	defer conn.Close()
+
+	// res 31, split from res 20 under agent 6 at t=8, goes to agent 4 at t=9
+	// and comes back at t=11 to be split again at t=12.
+	simid := "07947e67-0c8e-41a2-ad8e-15ecb77b4bde"
+	for _, stmt := range []string{
+		`INSERT INTO Transactions VALUES('` + simid + `',100,6,4,0,'fuel',0,9);`,
+		`INSERT INTO TransactedResources VALUES('` + simid + `',100,0,31);`,
+		`INSERT INTO Transactions VALUES('` + simid + `',101,4,6,0,'fuel',0,11);`,
+		`INSERT INTO TransactedResources VALUES('` + simid + `',101,0,31);`,
+		`INSERT INTO Resources VALUES('` + simid + `',200,'GenericResource',12,25.0,'kg',0,31,0);`,
+	} {
+		if err := conn.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, t0 := range []int{0, 10} {
+		g, err := AgentGraph(conn, simid, 6, t0, 12)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		if err := g.WriteDot(&buf); err != nil {
+			t.Fatal(err)
+		}
+		got := buf.String()
+		for _, edge := range []string{
+			`agent4 -> res31 [label="t=11\nqty 25"];`,
+			`res31 -> res200 [label="t=12\nqty 25"];`,
+		} {
+			if !strings.Contains(got, edge) {
+				t.Errorf("from t=%v: expected edge %v, got:\n%s", t0, edge, got)
+			}
+		}
+	}
+}
+
+func TestLineage(t *testing.T) {
+	conn := openTestDb(t)
+	defer conn.Close()
+
+	simid := "07947e67-0c8e-41a2-ad8e-15ecb77b4bde"
+	ctx := NewContext(conn, simid, nil)
+	if err := ctx.WalkAll(); err != nil {
+		t.Fatal(err)
+	}
+
+	lin, err := GetLineage(conn, simid, 15)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(lin.Res.Owners) != 1 || *lin.Res.Owners[0] != (Node{ResId: 15, OwnerId: 5, StartTime: 4, EndTime: 5, EndReason: EndSplit, Quantity: 93.75}) {
+		t.Errorf("expected res 15 owned by agent 5 over [4,5) until split, got %v", lin.Res.Owners)
+	}
+
+	var ids, roots []int
+	for _, p := range lin.Ancestors {
+		ids = append(ids, p.ResId)
+		if p.Root {
+			roots = append(roots, p.ResId)
+			if p.Creator != 4 {
+				t.Errorf("root %v: expected creator agent 4, got %v", p.ResId, p.Creator)
+			}
+		}
+	}
+	if got := fmt.Sprint(ids); got != "[1 2 3 4 5 7 8 9 11 13]" {
+		t.Errorf("expected ancestors [1 2 3 4 5 7 8 9 11 13], got %v", got)
+	}
+	if got := fmt.Sprint(roots); got != "[1 4 8]" {
+		t.Errorf("expected roots [1 4 8], got %v", got)
+	}
+
+	if n := len(lin.Descendants); n == 0 {
+		t.Fatal("expected descendants, got none")
+	}
+	first, last := lin.Descendants[0], lin.Descendants[len(lin.Descendants)-1]
+	if first.ResId != 17 || last.ResId != 186 {
+		t.Errorf("expected descendants 17 through 186, got %v through %v", first.ResId, last.ResId)
+	}
+	for _, p := range lin.Descendants {
+		if len(p.Owners) == 0 {
+			t.Errorf("descendant %v: expected owner timeline, got none", p.ResId)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	conn := openTestDb(t)
+	defer conn.Close()
+
+	simid := "07947e67-0c8e-41a2-ad8e-15ecb77b4bde"
+	ctx := NewContext(conn, simid, nil)
+	if err := ctx.WalkAll(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the fixture's quantities are only recorded to six significant figures
+	vs, err := Check(conn, simid, DefaultCheckTol)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range vs {
+		t.Errorf("unexpected violation %v", v)
+	}
+
+	// leak some mass from a combined resource and lose a root's creator
+	err = conn.Exec("UPDATE Resources SET Quantity = Quantity - 1 WHERE SimID = ? AND ID = 15;", simid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = conn.Exec("DELETE FROM ResCreators WHERE SimID = ? AND ResID = 8;", simid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vs, err = Check(conn, simid, DefaultCheckTol)
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := map[string]bool{}
+	for _, v := range vs {
+		found[fmt.Sprintf("%v res %v agent %v", v.Kind, v.ResId, v.AgentId)] = true
+	}
+	wants := []string{"combine res 15 agent 0", "no-creator res 8 agent 0", "inventory res 0 agent 5"}
+	for _, want := range wants {
+		if !found[want] {
+			t.Errorf("expected %v violation, got none", want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	conn := openTestDb(t)
+	defer conn.Close()
+
+	simid := "07947e67-0c8e-41a2-ad8e-15ecb77b4bde"
+	ctx := NewContext(conn, simid, nil)
+	ctx.Validate = true
+	if err := ctx.WalkAll(); err != nil {
+		t.Fatal(err)
+	}
+	as, err := GetAnomalies(conn, simid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, a := range as {
+		t.Errorf("unexpected anomaly %v", a)
+	}
+
+	// make res 15 (combined from 8 and 13) an ancestor of 13, create res 5
+	// before its parent 3 and transfer 3 after it was split.
+	stmts := []string{
+		"UPDATE Resources SET Parent2 = 15 WHERE SimID = ?1 AND ID = 13;",
+		"UPDATE Resources SET TimeCreated = 0 WHERE SimID = ?1 AND ID = 5;",
+		"UPDATE Transactions SET Time = 3 WHERE SimID = ?1 AND ID = 1;",
+	}
+	for _, sql := range stmts {
+		if err := conn.Exec(sql, simid); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := Clear(conn, simid); err != nil {
+		t.Fatal(err)
+	}
+	ctx = NewContext(conn, simid, nil)
+	ctx.Validate = true
+	ctx.Strict = true
+	if err := ctx.WalkAll(); err == nil {
+		t.Fatal("expected strict walk to fail, got nil")
+	}
+	if rows := inventoryRows(t, conn); len(rows) != 0 {
+		t.Errorf("expected no inventories after failed strict walk, got %v", len(rows))
+	}
+
+	as, err = GetAnomalies(conn, simid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := map[string]bool{}
+	for _, a := range as {
+		found[fmt.Sprintf("%v %v", a.Kind, a.ResId)] = true
+	}
+	for _, want := range []string{"cycle 13", "many-children 15", "child-before-parent 3", "late-transfer 3"} {
+		if !found[want] {
+			t.Errorf("expected %v anomaly, got none", want)
+		}
+	}
+}
+
+func TestLifetimes(t *testing.T) {
+	conn := openTestDb(t)
+	defer conn.Close()
+
+	// decommission a sink (agent 6) part way through the simulation
+	simid := "07947e67-0c8e-41a2-ad8e-15ecb77b4bde"
+	const death = 15
+	err := conn.Exec("UPDATE AgentDeaths SET DeathDate = ?2 WHERE SimID = ?1 AND AgentID = 6;", simid, death)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := NewContext(conn, simid, nil)
+	if err := ctx.WalkAll(); err != nil {
+		t.Fatal(err)
+	}
+
+	sql := "SELECT ResID,AgentID,StartTime,EndTime,EndReason FROM Inventories WHERE SimID = ?;"
+	reasons := map[string]int{}
+	rows, err := conn.Query(sql, simid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var n Node
+		if err := rows.Scan(&n.ResId, &n.OwnerId, &n.StartTime, &n.EndTime, &n.EndReason); err != nil {
+			t.Fatal(err)
+		}
+		reasons[n.EndReason]++
+
+		switch {
+		case n.EndTime > 25:
+			t.Errorf("resid %v: interval %v-%v extends past the end of the simulation", n.ResId, n.StartTime, n.EndTime)
+		case n.EndTime < n.StartTime:
+			t.Errorf("resid %v: interval %v-%v ends before it starts", n.ResId, n.StartTime, n.EndTime)
+		case n.OwnerId == 6 && n.EndTime > max(death, n.StartTime):
+			t.Errorf("resid %v: held by agent 6 until %v after it died at %v", n.ResId, n.EndTime, death)
+		case n.EndReason == EndDied && (n.OwnerId != 6 || n.EndTime != death || n.StartTime >= death):
+			t.Errorf("resid %v: unexpected death of agent %v at %v", n.ResId, n.OwnerId, n.EndTime)
+		case n.EndReason == EndSimEnd && n.EndTime != 25:
+			t.Errorf("resid %v: simulation end at %v, expected 25", n.ResId, n.EndTime)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, r := range []string{EndSplit, EndTransfer, EndDied, EndSimEnd} {
+		if reasons[r] == 0 {
+			t.Errorf("expected intervals ending by %q, got none", r)
+		}
+	}
+	if n := reasons[""]; n > 0 {
+		t.Errorf("expected every interval to have an end reason, got %v without", n)
+	}
+}
+
+func TestExport(t *testing.T) {
+	conn := openTestDb(t)
+	defer conn.Close()
+
+	simid := "07947e67-0c8e-41a2-ad8e-15ecb77b4bde"
+	ctx := NewContext(conn, simid, nil)
+	ctx.Quantities = true
+	if err := ctx.WalkAll(); err != nil {
+		t.Fatal(err)
+	}
+
+	sql := "SELECT ResID,AgentID,StartTime,EndTime,EndReason,Quantity FROM Inventories WHERE SimID = ?;"
+	want := map[Node]bool{}
+	rows, err := conn.Query(sql, simid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var n Node
+		if err := rows.Scan(&n.ResId, &n.OwnerId, &n.StartTime, &n.EndTime, &n.EndReason, &n.Quantity); err != nil {
+			t.Fatal(err)
+		}
+		want[n] = true
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	n := 0
+	err = Export(conn, simid, true, func(r *ExportRow) error {
+		n++
+		if !want[r.Node] {
+			t.Errorf("unexpected exported interval %+v", r.Node)
+		}
+		if r.Prototype == "" {
+			t.Errorf("resid %v: expected agent %v's prototype, got none", r.ResId, r.OwnerId)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != len(want) {
+		t.Errorf("expected %v exported intervals, got %v", len(want), n)
+	}
+
+	stop := fmt.Errorf("stop")
+	n = 0
+	err = Export(conn, simid, false, func(r *ExportRow) error {
+		if n++; n == 3 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop || n != 3 {
+		t.Errorf("expected export to stop after 3 rows with %v, got %v after %v", stop, err, n)
+	}
+}
+
+func TestAttachInput(t *testing.T) {
+	conn := openTestDb(t)
+	defer conn.Close()
+	simids, err := GetSimIds(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, simid := range simids {
+		if err := NewContext(conn, simid, nil).WalkAll(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := inventoryRows(t, conn)
+
+	inFile, outFile := tmpDbFile+".in", tmpDbFile+".out"
+	if err := os.RemoveAll(outFile); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(outFile)
+	defer os.Remove(inFile)
+	in := openRawDb(t, inFile)
+	schema := func() string {
+		rows, err := in.Query("SELECT name FROM sqlite_master ORDER BY name;")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rows.Close()
+		var names []string
+		for rows.Next() {
+			var name string
+			if err := rows.Scan(&name); err != nil {
+				t.Fatal(err)
+			}
+			names = append(names, name)
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatal(err)
+		}
+		return strings.Join(names, ",")
+	}
+	before := schema()
+
+	out, err := Open("sqlite3", outFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer out.Close()
+	if err := AttachInput(out, inFile); err != nil {
+		t.Fatal(err)
+	}
+	if err := out.Exec("CREATE TABLE " + InputSchema + ".Scratch (x);"); err == nil {
+		t.Error("expected input database to be read-only, created table in it")
+	}
+	if err := Prepare(out); err != nil {
+		t.Fatal(err)
+	}
+	for i, simid := range simids {
+		ctx := NewContext(out, simid, nil)
+		ctx.InMemory = i%2 == 0
+		if err := ctx.WalkAll(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := Finish(out); err != nil {
+		t.Fatal(err)
+	}
+
+	got := inventoryRows(t, out)
+	if len(got) != len(want) {
+		t.Fatalf("expected %v inventory rows in output database, got %v", len(want), len(got))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("row %v: expected %v, got %v", i, want[i], got[i])
+		}
+	}
+
+	if after := schema(); after != before {
+		t.Errorf("input database schema changed from %v to %v", before, after)
+	}
+
+	// relative paths and paths holding uri delimiters must attach too
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rel, err := filepath.Rel(wd, inFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	odd := tmpDbFile + ".in?x=1#y"
+	defer os.Remove(odd)
+	data, err := os.ReadFile(inFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(odd, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{rel, odd} {
+		c, err := Open("sqlite3", ":memory:")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := AttachInput(c, path); err != nil {
+			t.Errorf("attaching %v: %v", path, err)
+		} else if ids, err := GetSimIds(c); err != nil {
+			t.Errorf("reading %v: %v", path, err)
+		} else if len(ids) != len(simids) {
+			t.Errorf("reading %v: expected %v simids, got %v", path, len(simids), len(ids))
+		}
+		c.Close()
+	}
+	in.Close()
+}
+
+func TestIndexOptions(t *testing.T) {
+	conn := openRawDb(t, tmpDbFile)
+	defer conn.Close()
+
+	// a pre-existing index must survive temporary indexing
+	if err := conn.Exec(Index("Agents", "ID")); err != nil {
+		t.Fatal(err)
+	}
+	if err := PrepareWith(conn, IndexOptions{Temporary: true, Report: true}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"Resources_Parent1", "Agents_ID"} {
+		if ok, err := hasIndex(conn, name); err != nil {
+			t.Fatal(err)
+		} else if !ok {
+			t.Errorf("expected index %v after prepare, got none", name)
+		}
+	}
+
+	simid := "07947e67-0c8e-41a2-ad8e-15ecb77b4bde"
+	if err := NewContext(conn, simid, nil).WalkAll(); err != nil {
+		t.Fatal(err)
+	}
+	if err := Finish(conn); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]bool{"Resources_Parent1": false, "Agents_ID": true, "Inventories_SimID_AgentID": true}
+	for name, exists := range want {
+		if ok, err := hasIndex(conn, name); err != nil {
+			t.Fatal(err)
+		} else if ok != exists {
+			t.Errorf("index %v: expected existence %v after finish, got %v", name, exists, ok)
+		}
+	}
+	conn.Close()
+
+	conn = openRawDb(t, tmpDbFile)
+	if err := PrepareWith(conn, IndexOptions{Skip: true}); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := hasIndex(conn, "Resources_Parent1"); err != nil {
+		t.Fatal(err)
+	} else if ok {
+		t.Error("expected no indexes when skipped, got Resources_Parent1")
+	}
+	if ok, err := hasTable(conn, "InventoryTmpIndexes"); err != nil {
+		t.Fatal(err)
+	} else if ok {
+		t.Error("expected no InventoryTmpIndexes table when skipped, got one")
+	}
+	conn.Close()
+
+	// indexes that are kept aren't recorded
+	conn = openRawDb(t, tmpDbFile)
+	if err := PrepareWith(conn, IndexOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := hasTable(conn, "InventoryTmpIndexes"); err != nil {
+		t.Fatal(err)
+	} else if ok {
+		t.Error("expected no InventoryTmpIndexes table when keeping indexes, got one")
+	}
+	conn.Close()
+
+	// failures other than a missing table must be reported
+	conn = openRawDb(t, tmpDbFile)
+	if err := conn.Exec("ALTER TABLE Agents RENAME COLUMN Prototype TO Proto;"); err != nil {
+		t.Fatal(err)
+	}
+	if err := Prepare(conn); err == nil {
+		t.Error("expected error indexing a missing column, got nil")
+	}
+}
+
+type testLogger struct {
+	msgs     map[Level][]string
+	progress []Progress
+}
+
+func (l *testLogger) Logf(level Level, format string, args ...interface{}) {
+	l.msgs[level] = append(l.msgs[level], fmt.Sprintf(format, args...))
+}
+
+func (l *testLogger) Progress(p Progress) { l.progress = append(l.progress, p) }
+
+func TestLogger(t *testing.T) {
+	conn := openTestDb(t)
+	defer conn.Close()
+
+	simid := "07947e67-0c8e-41a2-ad8e-15ecb77b4bde"
+	l := &testLogger{msgs: map[Level][]string{}}
+	ctx := NewContext(conn, simid, nil)
+	ctx.Log = l
+	if err := ctx.WalkAll(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(l.msgs[Info]) == 0 || len(l.msgs[Verbose]) == 0 {
+		t.Errorf("expected info and verbose messages, got %v", l.msgs)
+	}
+	if len(l.progress) != 33 {
+		t.Fatalf("expected progress after each of 33 roots, got %v reports", len(l.progress))
+	}
+	last := l.progress[len(l.progress)-1]
+	if last.Percent() != 100 || last.Resources != 188 {
+		t.Errorf("expected final progress of 100%% with 188 resources, got %v%% with %v", last.Percent(), last.Resources)
+	}
+
+	var buf bytes.Buffer
+	for _, level := range []Level{Quiet, Info, Verbose} {
+		buf.Reset()
+		lg := NewLogger(&buf, level)
+		lg.Logf(Info, "info")
+		lg.Logf(Verbose, "verbose")
+		for _, p := range l.progress {
+			lg.Progress(p)
+		}
+
+		n := strings.Count(buf.String(), "\n")
+		switch {
+		case level == Quiet && n != 0:
+			t.Errorf("expected quiet logger to write nothing, got %q", buf.String())
+		case level == Info && (strings.Contains(buf.String(), "verbose") || n != 1+11):
+			t.Errorf("expected info and 11 progress lines from info logger, got %q", buf.String())
+		case level == Verbose && n != 2+11:
+			t.Errorf("expected all messages and 11 progress lines from verbose logger, got %q", buf.String())
+		}
+	}
+}
+
+func TestEvents(t *testing.T) {
+	conn := openTestDb(t)
+	defer conn.Close()
+
+	simid := "07947e67-0c8e-41a2-ad8e-15ecb77b4bde"
+	counts := map[EventKind]int{}
+	var events []Event
+	ctx := NewContext(conn, simid, func(e Event) {
+		counts[e.Kind]++
+		events = append(events, e)
+	})
+	if err := ctx.WalkAll(); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := counts[RootStart]; n != 33 {
+		t.Errorf("expected 33 root-start events, got %v", n)
+	}
+	if n := counts[Interval]; n != len(inventoryRows(t, conn)) {
+		t.Errorf("expected an interval event per inventory row, got %v", n)
+	}
+	if counts[Split] == 0 || counts[Transfer] == 0 {
+		t.Errorf("expected split and transfer events, got %v", counts)
+	}
+
+	for _, e := range events {
+		switch {
+		case e.Simid != simid:
+			t.Errorf("%v event: expected simid %v, got %v", e.Kind, simid, e.Simid)
+		case e.Kind == Split && (len(e.Kids) == 0 || e.Node.EndTime > e.Time):
+			t.Errorf("resid %v: bad split event %+v", e.Node.ResId, e)
+		case e.Kind == Transfer && (e.From == e.Node.OwnerId || e.Node.StartTime != e.Time):
+			t.Errorf("resid %v: bad transfer event %+v", e.Node.ResId, e)
+		}
+	}
+
+	e := Event{Kind: Interval, Simid: "it's", Node: Node{ResId: 1, OwnerId: 2, StartTime: 3, EndTime: 4}}
+	if want := "INSERT INTO Inventories VALUES('it''s',1,2,3,4);"; e.SQL() != want {
+		t.Errorf("expected %v, got %v", want, e.SQL())
+	}
+}
+
+func TestMemStore(t *testing.T) {
+	// resource 1 is created by agent 10, transferred to agent 20 and then
+	// split into 2 and 3, the latter of which is transferred on to agent 30.
+	// Agent 20 is decommissioned before the simulation ends.
+	s := NewMemStore(10)
+	s.AddRoot(1, 0, 10, 10)
+	s.AddTransfer(1, 1, 2, 10, 20, "fuel")
+	s.AddResource(2, 3, 5, 1)
+	s.AddResource(3, 3, 5, 1)
+	s.AddTransfer(3, 2, 4, 20, 30, "waste")
+	s.AddDeath(20, 6)
+
+	// validation needs the cyclus tables, which a custom Source lacks
+	ctx := &Context{Simid: "sim", Source: s, Sink: s, Validate: true}
+	if err := ctx.WalkAll(); err == nil {
+		t.Fatal("expected validating a custom Source to fail")
+	} else if len(s.Intervals) > 0 || len(s.Completed) > 0 {
+		t.Fatalf("expected nothing written by a rejected walk, got %+v", s.Intervals)
+	}
+
+	ctx = &Context{Simid: "sim", Source: s, Sink: s, Quantities: true, Flows: true}
+	if err := ctx.WalkAll(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Node{
+		{ResId: 1, OwnerId: 20, StartTime: 2, EndTime: 3, EndReason: EndSplit, Quantity: 10},
+		{ResId: 1, OwnerId: 10, StartTime: 0, EndTime: 2, EndReason: EndTransfer, Quantity: 10},
+		{ResId: 2, OwnerId: 20, StartTime: 3, EndTime: 6, EndReason: EndDied, Quantity: 5},
+		{ResId: 3, OwnerId: 30, StartTime: 4, EndTime: 10, EndReason: EndSimEnd, Quantity: 5},
+		{ResId: 3, OwnerId: 20, StartTime: 3, EndTime: 4, EndReason: EndTransfer, Quantity: 5},
+	}
+	if len(s.Intervals) != len(want) {
+		t.Fatalf("expected %v intervals, got %v: %+v", len(want), len(s.Intervals), s.Intervals)
+	}
+	for i := range want {
+		if s.Intervals[i] != want[i] {
+			t.Errorf("interval %v: expected %+v, got %+v", i, want[i], s.Intervals[i])
+		}
+	}
+
+	wantFlows := []Flow{
+		{TransactionID: 1, SenderID: 10, ReceiverID: 20, Commodity: "fuel", Time: 2, Quantity: 10},
+		{TransactionID: 2, SenderID: 20, ReceiverID: 30, Commodity: "waste", Time: 4, Quantity: 5},
+	}
+	if len(s.Flows) != len(wantFlows) {
+		t.Fatalf("expected %v flows, got %v", len(wantFlows), len(s.Flows))
+	}
+	for i := range wantFlows {
+		if *s.Flows[i] != wantFlows[i] {
+			t.Errorf("flow %v: expected %+v, got %+v", i, wantFlows[i], *s.Flows[i])
+		}
+	}
+
+	if len(s.Completed) != 1 || s.Completed[0] != "sim" {
+		t.Errorf("expected simulation to be marked complete, got %v", s.Completed)
+	}
+}
+
+func TestSelfTransfer(t *testing.T) {
+	// resource 20 is transferred to agent 20, whose id happens to equal its
+	// own, and then by agent 20 to itself, which changes nothing.
+	s := NewMemStore(10)
+	s.AddRoot(20, 0, 10, 10)
+	s.AddTransfer(20, 1, 2, 10, 20, "fuel")
+	s.AddTransfer(20, 2, 4, 20, 20, "fuel")
+
+	ctx := &Context{Simid: "sim", Source: s, Sink: s, Quantities: true, Flows: true}
+	if err := ctx.WalkAll(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Node{
+		{ResId: 20, OwnerId: 20, StartTime: 2, EndTime: 10, EndReason: EndSimEnd, Quantity: 10},
+		{ResId: 20, OwnerId: 10, StartTime: 0, EndTime: 2, EndReason: EndTransfer, Quantity: 10},
+	}
+	if len(s.Intervals) != len(want) {
+		t.Fatalf("expected %v intervals, got %v: %+v", len(want), len(s.Intervals), s.Intervals)
+	}
+	for i := range want {
+		if s.Intervals[i] != want[i] {
+			t.Errorf("interval %v: expected %+v, got %+v", i, want[i], s.Intervals[i])
+		}
+	}
+
+	// every transaction is a flow, including the self-transfer
+	if len(s.Flows) != 2 {
+		t.Errorf("expected 2 flows, got %v", len(s.Flows))
+	}
+}
+
+func TestDeadReceiver(t *testing.T) {
+	// agent 20 exits at t=3 but is sent resource 1 at t=5, under which it is
+	// split at t=7.  Neither gets an interval with agent 20.
+	s := NewMemStore(10)
+	s.AddRoot(1, 0, 10, 10)
+	s.AddTransfer(1, 1, 5, 10, 20, "fuel")
+	s.AddResource(2, 7, 10, 1)
+	s.AddDeath(20, 3)
+
+	ctx := &Context{Simid: "sim", Source: s, Sink: s, Quantities: true}
+	if err := ctx.WalkAll(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Node{
+		{ResId: 1, OwnerId: 10, StartTime: 0, EndTime: 5, EndReason: EndTransfer, Quantity: 10},
+	}
+	if len(s.Intervals) != len(want) {
+		t.Fatalf("expected %v intervals, got %v: %+v", len(want), len(s.Intervals), s.Intervals)
+	}
+	for i := range want {
+		if s.Intervals[i] != want[i] {
+			t.Errorf("interval %v: expected %+v, got %+v", i, want[i], s.Intervals[i])
+		}
+	}
+}
+
+func TestDialect(t *testing.T) {
+	pg := &Conn{Dialect: Postgres}
+	tests := []struct {
+		sql, want string
+	}{
+		{"SELECT * FROM t WHERE a = ? AND b = ?;", "SELECT * FROM t WHERE a = $1 AND b = $2;"},
+		{"SELECT * FROM t WHERE a = ?1 AND b = ?2 AND c = ?1;", "SELECT * FROM t WHERE a = $1 AND b = $2 AND c = $1;"},
+		{"SELECT COUNT(*) FROM t;", "SELECT COUNT(*) FROM t;"},
+	}
+	for _, test := range tests {
+		if got := pg.rebind(test.sql); got != test.want {
+			t.Errorf("%v: expected %v, got %v", test.sql, test.want, got)
+		}
+		if got := (&Conn{}).rebind(test.sql); got != test.sql {
+			t.Errorf("%v: expected sqlite sql to be unchanged, got %v", test.sql, got)
+		}
+	}
+
+	ddl := "CREATE TABLE t (SimID TEXT,ResID INTEGER,Quantity REAL);"
+	if want := "CREATE TABLE t (SimID TEXT,ResID BIGINT,Quantity DOUBLE PRECISION);"; pg.ddl(ddl) != want {
+		t.Errorf("expected %v, got %v", want, pg.ddl(ddl))
+	}
+
+	if d := DriverDialect("postgres"); d != Postgres {
+		t.Errorf("expected postgres driver to speak %v, got %v", Postgres, d)
+	}
+	if d := DriverDialect("sqlite"); d != SQLite {
+		t.Errorf("expected sqlite driver to speak %v, got %v", SQLite, d)
+	}
+
+	pg1 := &Conn{Dialect: Postgres, Schema: Schema1}
+	sql := "SELECT {Resources.ID} FROM {TransactedResources} WHERE SimID = ?1 AND ResourceID = ?2 AND {Resources.ID} > ?1;"
+	want := "SELECT ResourceId FROM Transactions WHERE SimID = $1 AND ResourceID = $2 AND ResourceId > $1;"
+	if got := pg1.rebind(sql); got != want {
+		t.Errorf("%v: expected %v, got %v", sql, want, got)
+	}
+}
+
+// TestPostgresSql checks the sql sent to a postgres database while preparing
+// and finishing it, using a driver that records each statement.  Counts and
+// sizes are answered with zero and other queries with no rows.
+func TestPostgresSql(t *testing.T) {
+	db, err := sqldb.Open(recordDriverName, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	conn, err := NewConn(db, Postgres)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.Schema = Schema1
+
+	recorded = nil
+	if err := PrepareWith(conn, IndexOptions{Temporary: true, Report: true}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := hasTable(conn, "Inventories"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := columns(conn, "Inventories"); err != nil {
+		t.Fatal(err)
+	}
+	if err := Finish(conn); err != nil {
+		t.Fatal(err)
+	}
+
+	var creates, lookups int
+	for _, stmt := range recorded {
+		if strings.ContainsAny(stmt, "?{}") {
+			t.Errorf("untranslated sql: %v", stmt)
+		}
+		if strings.HasPrefix(stmt, "CREATE TABLE") {
+			creates++
+		}
+		if strings.HasPrefix(stmt, "CREATE TABLE") || strings.HasPrefix(stmt, "ALTER TABLE") {
+			if strings.Contains(stmt, " INTEGER") || strings.Contains(stmt, " REAL") {
+				t.Errorf("sqlite column types in postgres table definition: %v", stmt)
+			}
+		}
+		if strings.Contains(stmt, "current_schemas(true)") {
+			lookups++
+			if !strings.Contains(stmt, "lower($1)") {
+				t.Errorf("expected introspection sql to take its name as $1: %v", stmt)
+			}
+		}
+		if strings.Contains(stmt, "pragma_") || strings.Contains(stmt, "sqlite_") {
+			t.Errorf("sqlite specific sql sent to postgres: %v", stmt)
+		}
+	}
+	// temporary indexing adds InventoryTmpIndexes
+	if creates != len(preExecStmts)+1 {
+		t.Errorf("expected %v tables created, got %v", len(preExecStmts)+1, creates)
+	}
+	if lookups == 0 {
+		t.Errorf("expected table, column and index lookups, got none in %v", recorded)
+	}
+}
+
+// recordDriverName is the name of recordDriver, whose connections record
+// each statement sent to them in recorded.
+const recordDriverName = "inv-record"
+
+var recorded []string
+
+func init() {
+	sqldb.Register(recordDriverName, recordDriver{})
+}
+
+type recordDriver struct{}
+
+func (recordDriver) Open(name string) (driver.Conn, error) { return recordConn{}, nil }
+
+type recordConn struct{}
+
+func (recordConn) Prepare(query string) (driver.Stmt, error) { return recordStmt(query), nil }
+func (recordConn) Close() error                              { return nil }
+func (recordConn) Begin() (driver.Tx, error)                 { return nil, errors.New("not supported") }
+
+type recordStmt string
+
+func (s recordStmt) Close() error  { return nil }
+func (s recordStmt) NumInput() int { return -1 }
+
+func (s recordStmt) Exec(args []driver.Value) (driver.Result, error) {
+	recorded = append(recorded, string(s))
+	return driver.RowsAffected(0), nil
+}
+
+func (s recordStmt) Query(args []driver.Value) (driver.Rows, error) {
+	recorded = append(recorded, string(s))
+	if strings.Contains(string(s), "column_name") {
+		return &recordRows{}, nil
+	}
+	return &recordRows{n: 1}, nil
+}
+
+// recordRows holds n rows of a single zero.
+type recordRows struct{ n int }
+
+func (r *recordRows) Columns() []string { return []string{"n"} }
+func (r *recordRows) Close() error      { return nil }
+
+func (r *recordRows) Next(dest []driver.Value) error {
+	if r.n == 0 {
+		return io.EOF
+	}
+	r.n--
+	dest[0] = int64(0)
+	return nil
+}
+
+// TestPostgres walks the test simulations in a PostgreSQL database, which
+// must be given by the INVENTORY_TEST_POSTGRES environment variable as a
+// connection url (e.g. postgres://localhost/test?sslmode=disable).  The test
+// works in its own inventory_test schema, which is dropped and recreated.
+func TestPostgres(t *testing.T) {
+	dsn := os.Getenv("INVENTORY_TEST_POSTGRES")
+	if dsn == "" {
+		t.Skip("INVENTORY_TEST_POSTGRES not set")
+	}
+
+	lite := openTestDb(t)
+	defer lite.Close()
+	for _, simid := range []string{"07947e67-0c8e-41a2-ad8e-15ecb77b4bde", "f5cc4a28-729f-4e1c-b183-c624a8e94984"} {
+		if err := NewContext(lite, simid, nil).WalkAll(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := inventoryRows(t, lite)
+
+	conn, err := Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	setup := []string{
+		"DROP SCHEMA IF EXISTS inventory_test CASCADE;",
+		"CREATE SCHEMA inventory_test;",
+		"SET search_path TO inventory_test;",
+	}
+	for _, sql := range setup {
+		if err := conn.Exec(sql); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, sql := range rawSimSql {
+		// unquote table names so they are case insensitive like ours
+		if err := conn.Exec(conn.ddl(strings.Replace(sql, `"`, "", -1))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := PrepareWith(conn, IndexOptions{Temporary: true, Report: true}); err != nil {
+		t.Fatal(err)
+	}
+	for i, simid := range []string{"07947e67-0c8e-41a2-ad8e-15ecb77b4bde", "f5cc4a28-729f-4e1c-b183-c624a8e94984"} {
+		ctx := NewContext(conn, simid, nil)
+		ctx.InMemory = i == 1
+		ctx.Quantities = true
+		ctx.Flows = true
+		ctx.Validate = true
+		if err := ctx.WalkAll(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := Finish(conn); err != nil {
+		t.Fatal(err)
+	}
+
+	got := inventoryRows(t, conn)
+	if len(got) != len(want) {
+		t.Fatalf("expected %v inventory rows, got %v", len(want), len(got))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("row %v: expected %v, got %v", i, want[i], got[i])
+		}
+	}
+
+	simid := "07947e67-0c8e-41a2-ad8e-15ecb77b4bde"
+	for _, agent := range []int{4, 5, 8} {
+		wantSeries, err := Series(lite, simid, agent)
+		if err != nil {
+			t.Fatal(err)
+		}
+		series, err := Series(conn, simid, agent)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for tm := range wantSeries {
+			if math.Abs(series[tm]-wantSeries[tm]) > 1e-9 {
+				t.Errorf("agent %v at t=%v: expected %v, got %v", agent, tm, wantSeries[tm], series[tm])
+			}
+		}
+	}
+
+	wantVs, err := Check(lite, simid, DefaultCheckTol)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vs, err := Check(conn, simid, DefaultCheckTol); err != nil {
+		t.Fatal(err)
+	} else if len(vs) != len(wantVs) {
+		t.Errorf("expected %v violations, got %v", wantVs, vs)
+	}
+	if _, err := GetLineage(conn, simid, 15); err != nil {
+		t.Fatal(err)
+	}
+	if err := Export(conn, simid, true, func(r *ExportRow) error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHDF5(t *testing.T) {
+	conn := openTestDb(t)
+	defer conn.Close()
+	simids, err := GetSimIds(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, simid := range simids {
+		ctx := NewContext(conn, simid, nil)
+		ctx.Quantities = true
+		if err := ctx.WalkAll(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// simulation ids are stored as uuids, as by cyclus, and all else as
+	// fixed size numbers and strings
+	tables := map[string]*h5Table{}
+	for _, name := range hdf5Tables {
+		tables[name] = h5TableFrom(t, conn, name)
+	}
+	in, err := newHDF5Input(tables)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h5ids, err := in.SimIds()
+	if err != nil {
+		t.Fatal(err)
+	} else if strings.Join(h5ids, ",") != strings.Join(simids, ",") {
+		t.Fatalf("expected simids %v, got %v", simids, h5ids)
+	}
+
+	outFile := tmpDbFile + ".out"
+	if err := os.RemoveAll(outFile); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(outFile)
+	out, err := Open("sqlite3", outFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer out.Close()
+	if err := Prepare(out); err != nil {
+		t.Fatal(err)
+	}
+	for _, simid := range h5ids {
+		s, err := in.Store(simid, Build{Quantities: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx := NewContext(out, simid, nil)
+		ctx.Source = s
+		ctx.Quantities = true
+		if err := ctx.WalkAll(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	intervals := func(conn *Conn) (lines []string) {
+		sql := `SELECT SimID,ResID,AgentID,StartTime,EndTime,Quantity,Units,Type,EndReason FROM Inventories
+				ORDER BY SimID,ResID,AgentID,StartTime,EndTime`
+		rows, err := conn.Query(sql)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var n Node
+			var simid string
+			if err := rows.Scan(&simid, &n.ResId, &n.OwnerId, &n.StartTime, &n.EndTime, &n.Quantity, &n.Units, &n.Type, &n.EndReason); err != nil {
+				t.Fatal(err)
+			}
+			lines = append(lines, fmt.Sprintf("%v,%+v", simid, n))
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatal(err)
+		}
+		return lines
+	}
+	want, got := intervals(conn), intervals(out)
+	if len(got) != len(want) {
+		t.Fatalf("expected %v inventory rows from hdf5 tables, got %v", len(want), len(got))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("row %v: expected %v, got %v", i, want[i], got[i])
+		}
+	}
+
+	h5File := tmpDbFile + ".h5"
+	defer os.Remove(h5File)
+	if err := os.WriteFile(h5File, append(make([]byte, 1024), hdf5Signature...), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := IsHDF5(h5File); err != nil {
+		t.Fatal(err)
+	} else if !ok {
+		t.Errorf("expected signature after 1024 byte user block to be found")
+	}
+	if ok, err := IsHDF5(tmpDbFile); err != nil {
+		t.Fatal(err)
+	} else if ok {
+		t.Errorf("expected sqlite db not to be detected as hdf5")
+	}
+	if _, err := OpenHDF5(h5File); err == nil {
+		t.Errorf("expected error reading truncated hdf5 file")
+	}
+}
+
+func TestHDF5VarLenStrings(t *testing.T) {
+	conn := openTestDb(t)
+	defer conn.Close()
+	simids, err := GetSimIds(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// cyclus writes strings without a declared length as digests of values
+	// kept elsewhere, which can't be decoded
+	tables := map[string]*h5Table{}
+	for _, name := range hdf5Tables {
+		tables[name] = h5TableFrom(t, conn, name)
+	}
+	for _, col := range [][2]string{{"Resources", "type"}, {"Transactions", "commodity"}} {
+		f := tables[col[0]].fields[col[1]]
+		f.class = h5Other
+		tables[col[0]].fields[col[1]] = f
+	}
+	in, err := newHDF5Input(tables)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := in.Store(simids[0], Build{})
+	if err != nil {
+		t.Fatalf("expected walking without strings to succeed, got %v", err)
+	}
+	ctx := &Context{Simid: simids[0], Source: s, Sink: s}
+	if err := ctx.WalkAll(); err != nil {
+		t.Fatal(err)
+	} else if len(s.Intervals) == 0 {
+		t.Error("expected intervals, got none")
+	}
+
+	for _, b := range []Build{{Quantities: true}, {Flows: true}} {
+		if _, err := in.Store(simids[0], b); err == nil {
+			t.Errorf("%+v: expected error reading variable length strings, got nil", b)
+		}
+	}
+}
+
+func TestSchema(t *testing.T) {
+	// both generations of the test simulations must give the same results
+	// through every query of the cyclus tables
+	want := schemaResults(t, rawSimSql, Schema0)
+	got := schemaResults(t, rawSimSql1, Schema1)
+	if len(got) != len(want) {
+		t.Fatalf("expected %v result lines, got %v", len(want), len(got))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("line %v: expected %v, got %v", i, want[i], got[i])
+		}
+	}
+
+	tests := []struct {
+		schema    Schema
+		sql, want string
+	}{
+		{Schema0, "SELECT {Resources.ID} FROM {Agents} AS ag", "SELECT ID FROM Agents AS ag"},
+		{Schema1, "SELECT {Resources.ID} FROM {Agents} AS ag", "SELECT ResourceId FROM AgentEntry AS ag"},
+		{Schema1, "SELECT Quantity FROM {TransactedResources}", "SELECT Quantity FROM Transactions"},
+		{Schema1, "SELECT {Resources.Parent1}", "SELECT Parent1"},
+	}
+	for _, test := range tests {
+		if got := test.schema.translate(test.sql); got != test.want {
+			t.Errorf("%v: expected %q to translate to %q, got %q", test.schema, test.sql, test.want, got)
+		}
+	}
+}
+
+// schemaResults loads the test simulations from fixture, which holds them in
+// the given schema, and returns the results of walking them and of querying
+// them with every API that reads the cyclus tables.
+func schemaResults(t *testing.T, fixture []string, schema Schema) (lines []string) {
+	conn := openFixtureDb(t, tmpDbFile, fixture)
+	defer conn.Close()
+	if conn.Schema != schema {
+		t.Fatalf("expected %v schema to be detected, got %v", schema, conn.Schema)
+	}
+	if err := PrepareWith(conn, IndexOptions{Temporary: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	// every resource in the test data has StateID 0
+	if err := conn.Exec("CREATE TABLE Compositions (SimID BLOB, {Compositions.ID} INTEGER, {Compositions.IsoID} INTEGER, {Compositions.Quantity} REAL);"); err != nil {
+		t.Fatal(err)
+	}
+	simids, err := GetSimIds(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, simid := range simids {
+		id := conn.simId(simid)
+		if err := conn.Exec("INSERT INTO Compositions VALUES (?,0,92235,0.05),(?,0,92238,0.95);", id, id); err != nil {
+			t.Fatal(err)
+		}
+		ctx := NewContext(conn, simid, nil)
+		ctx.InMemory = i%2 == 0
+		ctx.Quantities, ctx.Flows, ctx.Validate = true, true, true
+		if err := ctx.WalkAll(); err != nil {
+			t.Fatal(err)
+		}
+		if err := BuildNuclides(conn, simid); err != nil {
+			t.Fatal(err)
+		}
+	}
+	lines = inventoryRows(t, conn)
+
+	for _, simid := range simids {
+		add := func(label string, v interface{}, err error) {
+			if err != nil {
+				t.Fatalf("%v %v: %v", schema, label, err)
+			}
+			lines = append(lines, fmt.Sprintf("%v %v: %v", simid, label, v))
+		}
+		dur, err := Duration(conn, simid)
+		add("duration", dur, err)
+		agents, err := PrototypeAgents(conn, simid, "dairy source")
+		add("agents", agents, err)
+		series, err := Series(conn, simid, 5, 6, 7, 9)
+		add("series", series, err)
+		nucs, err := Nuclides(conn, simid, 10, 5, 6, 7, 9)
+		add("nuclides", nucs, err)
+		vs, err := Check(conn, simid, DefaultCheckTol)
+		add("violations", len(vs), err)
+		as, err := Validate(conn, simid)
+		add("anomalies", len(as), err)
+
+		flows, err := GetFlows(conn, simid)
+		var fs []Flow
+		for _, f := range flows {
+			fs = append(fs, *f)
+		}
+		add("flows", fs, err)
+
+		l, err := GetLineage(conn, simid, 15)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := *l.Res
+		res.Owners = nil
+		add("lineage", fmt.Sprintf("%+v %v %v %v", res, len(l.Res.Owners), len(l.Ancestors), len(l.Descendants)), nil)
+
+		g, err := AgentGraph(conn, simid, 5, 0, 25)
+		var buf bytes.Buffer
+		if err == nil {
+			err = g.WriteDot(&buf)
+		}
+		add("graph", buf.Len(), err)
+
+		var rows []string
+		err = Export(conn, simid, true, func(r *ExportRow) error {
+			rows = append(rows, fmt.Sprintf("%+v", *r))
+			return nil
+		})
+		add("export", rows, err)
+	}
+
+	// the hdf5 input must map the names of either schema too
+	tables := map[string]*h5Table{}
+	for _, name := range hdf5Tables {
+		name = schema.Name(name)
+		tables[name] = h5TableFrom(t, conn, name)
+	}
+	in, err := newHDF5Input(tables)
+	if err != nil {
+		t.Fatal(err)
+	} else if in.schema != schema {
+		t.Errorf("expected %v schema to be detected for hdf5, got %v", schema, in.schema)
+	}
+	for _, simid := range simids {
+		s, err := in.Store(simid, Build{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx := &Context{Simid: simid, Source: s, Sink: s}
+		if err := ctx.WalkAll(); err != nil {
+			t.Fatal(err)
+		}
+		lines = append(lines, fmt.Sprintf("%v hdf5 intervals: %v", simid, len(s.Intervals)))
+	}
+
+	if err := Finish(conn); err != nil {
+		t.Fatal(err)
+	}
+	return lines
+}
+
+func TestFilterSimIds(t *testing.T) {
+	simids := []string{"abc-1", "abd-2", "bcd-3"}
+
+	tests := []struct {
+		prefixes []string
+		want     []string
+	}{
+		{[]string{"abc-1"}, []string{"abc-1"}},
+		{[]string{"ab"}, []string{"abc-1", "abd-2"}},
+		{[]string{"bcd-3", "abc"}, []string{"abc-1", "bcd-3"}},
+		{[]string{"a", "abd"}, []string{"abc-1", "abd-2"}},
+	}
+	for _, test := range tests {
+		got, err := FilterSimIds(simids, test.prefixes...)
+		if err != nil {
+			t.Errorf("%v: %v", test.prefixes, err)
+		} else if strings.Join(got, ",") != strings.Join(test.want, ",") {
+			t.Errorf("%v: expected %v, got %v", test.prefixes, test.want, got)
+		}
+	}
+
+	if _, err := FilterSimIds(simids, "xyz"); err == nil {
+		t.Errorf("expected error for unmatched prefix")
+	}
+}
+
+// checkQuantities verifies that all n inventory rows in conn carry the
+// quantity, units and type of their resource.
+func checkQuantities(t *testing.T, conn *Conn, n int) {
+	sql := `SELECT COUNT(*) FROM Inventories AS inv
+			INNER JOIN Resources AS res ON inv.SimID = res.SimID AND inv.ResID = res.ID
+			WHERE inv.Quantity = res.Quantity AND inv.Units = res.units AND inv.Type = res.Type`
+	count := 0
+	if err := conn.QueryRow(sql).Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+
+	if count != n {
+		t.Errorf("expected %v inventory rows with matching quantities, got %v", n, count)
+	}
+}
+
+// openTestDb creates a fresh database at tmpDbFile populated with rawSimSql
+// and prepared for walking.
+func openTestDb(t *testing.T) *Conn {
+	conn := openRawDb(t, tmpDbFile)
+	if err := Prepare(conn); err != nil {
+		t.Fatal(err)
+	}
+	return conn
+}
+
+// openRawDb creates a fresh database at file holding just the raw cyclus
+// tables of the test simulations.
+func openRawDb(t *testing.T, file string) *Conn {
+	return openFixtureDb(t, file, rawSimSql)
+}
+
+// openFixtureDb creates a fresh database at file by executing the
+// statements of fixture and detects the schema of the cyclus tables created.
+func openFixtureDb(t *testing.T, file string, fixture []string) *Conn {
+	if err := os.RemoveAll(file); err != nil {
+		t.Fatal(err)
+	}
+
+	conn, err := Open("sqlite3", file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, sql := range fixture {
+		if err := conn.Exec(sql); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := conn.detect(); err != nil {
+		t.Fatal(err)
+	}
+	return conn
+}
+
+// inventoryRows returns every row of the Inventories table in a canonical
+// order.
+func inventoryRows(t *testing.T, conn *Conn) []string {
+	sql := `SELECT SimID,ResID,AgentID,StartTime,EndTime FROM Inventories
+			ORDER BY SimID,ResID,AgentID,StartTime,EndTime`
+	rows, err := conn.Query(sql)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var lines []string
+	for rows.Next() {
+		var simid string
+		var resid, agent, start, end int
+		if err := rows.Scan(&simid, &resid, &agent, &start, &end); err != nil {
+			t.Fatal(err)
+		}
+		lines = append(lines, fmt.Sprintf("%v,%v,%v,%v,%v", simid, resid, agent, start, end))
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return lines
+}
+
+// h5TableFrom lays out the rows of table in conn as the records of a cyclus
+// HDF5 table.  SimID columns become uuids, INTEGER columns 4 byte integers,
+// REAL columns 8 byte floats and all else 32 byte strings.
+func h5TableFrom(t *testing.T, conn *Conn, table string) *h5Table {
+	rows, err := conn.Query("SELECT * FROM " + table + ";")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	types, err := rows.ColumnTypes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h5 := &h5Table{fields: map[string]h5Field{}}
+	fields := make([]h5Field, len(types))
+	for i, ct := range types {
+		f := h5Field{class: h5String, offset: h5.size, size: 32}
+		switch {
+		case strings.EqualFold(ct.Name(), "SimID"):
+			f.class, f.size = h5UUID, 16
+		case ct.DatabaseTypeName() == "INTEGER":
+			f.class, f.size = h5Int, 4
+		case ct.DatabaseTypeName() == "REAL":
+			f.class, f.size = h5Float, 8
+		}
+		fields[i] = f
+		h5.fields[strings.ToLower(ct.Name())] = f
+		h5.size += f.size
+	}
+
+	vals := make([]interface{}, len(fields))
+	for i, f := range fields {
+		switch f.class {
+		case h5Int:
+			vals[i] = new(int64)
+		case h5Float:
+			vals[i] = new(float64)
+		case h5UUID:
+			vals[i] = new([]byte)
+		default:
+			vals[i] = new(string)
+		}
+	}
+	for rows.Next() {
+		if err := rows.Scan(vals...); err != nil {
+			t.Fatal(err)
+		}
+		rec := make([]byte, h5.size)
+		for i, f := range fields {
+			b := rec[f.offset : f.offset+f.size]
+			switch f.class {
+			case h5Int:
+				binary.LittleEndian.PutUint32(b, uint32(int32(*vals[i].(*int64))))
+			case h5Float:
+				binary.LittleEndian.PutUint64(b, math.Float64bits(*vals[i].(*float64)))
+			case h5UUID:
+				// ids are stored as blobs by cyclus 1.x and as text before
+				if id := *vals[i].(*[]byte); len(id) == 16 {
+					copy(b, id)
+				} else if _, err := hex.Decode(b, bytes.Replace(id, []byte("-"), nil, -1)); err != nil {
+					t.Fatal(err)
+				}
+			default:
+				copy(b, *vals[i].(*string))
+			}
+		}
+		h5.data = append(h5.data, rec...)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return h5
+}
+
+// writeChain creates a minimal cyclus schema in conn holding a simulation
+// with a single chain of n resources owned by agent 1, where resource i+1 is
+// created from resource i at time i.
+func writeChain(conn *Conn, simid string, n int) error {
+	if err := writeSchema(conn, simid, n); err != nil {
+		return err
+	}
+	if err := conn.Exec("INSERT INTO ResCreators VALUES (?,1,1);", simid); err != nil {
+		return err
+	}
+
+	if err := conn.Exec("BEGIN TRANSACTION;"); err != nil {
+		return err
+	}
+	stmt, err := conn.Prepare("INSERT INTO Resources VALUES (?,?,'GenericResource',?,1.0,'kg',0,?,0);")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for id := 1; id <= n; id++ {
+		if _, err := stmt.Exec(simid, id, id-1, id-1); err != nil {
+			return err
+		}
+	}
+	return conn.Exec("END TRANSACTION;")
+}
+
+// writeSynthetic creates a minimal cyclus schema in conn holding a
+// simulation with n resources.  Every 100th resource is a root created by
+// agent 1 and the rest are split from their predecessor, every 10th also
+// being combined with an earlier resource.  Every 7th resource is transferred
+// to one of agents 2-4.
+func writeSynthetic(conn *Conn, simid string, n int) error {
+	if err := writeSchema(conn, simid, n/1000+1); err != nil {
+		return err
+	}
+
+	if err := conn.Exec("BEGIN TRANSACTION;"); err != nil {
+		return err
+	}
+	resStmt, err := conn.Prepare("INSERT INTO Resources VALUES (?,?,'GenericResource',?,1.0,'kg',0,?,?);")
+	if err != nil {
+		return err
+	}
+	defer resStmt.Close()
+	rootStmt, err := conn.Prepare("INSERT INTO ResCreators VALUES (?,?,1);")
+	if err != nil {
+		return err
+	}
+	defer rootStmt.Close()
+	txStmt, err := conn.Prepare("INSERT INTO Transactions VALUES (?,?,1,?,0,'stuff',0,?);")
+	if err != nil {
+		return err
+	}
+	defer txStmt.Close()
+	trStmt, err := conn.Prepare("INSERT INTO TransactedResources VALUES (?,?,0,?,1.0);")
+	if err != nil {
+		return err
+	}
+	defer trStmt.Close()
+
+	for id := 1; id <= n; id++ {
+		t := id / 1000
+		parent1, parent2 := id-1, 0
+		if id%100 == 1 {
+			parent1 = 0
+			if _, err := rootStmt.Exec(simid, id); err != nil {
+				return err
+			}
+		} else if id%10 == 0 {
+			parent2 = id - 5
+		}
+		if _, err := resStmt.Exec(simid, id, t, parent1, parent2); err != nil {
+			return err
+		}
+
+		if id%7 == 0 {
+			txid := id / 7
+			if _, err := txStmt.Exec(simid, txid, 2+id%3, t); err != nil {
+				return err
+			}
+			if _, err := trStmt.Exec(simid, txid, id); err != nil {
+				return err
+			}
+		}
+	}
+	return conn.Exec("END TRANSACTION;")
+}
+
+// writeSchema creates the cyclus tables needed for walking in conn along with
+// a simulation simid of the given duration and a single agent.
+func writeSchema(conn *Conn, simid string, duration int) error {
+	stmts := []string{
+		"CREATE TABLE SimulationTimeInfo (SimID TEXT, SimHandle TEXT, InitialYear INTEGER, InitialMonth INTEGER, SimulationStart INTEGER, Duration INTEGER);",
+		"CREATE TABLE Agents (SimID TEXT, ID INTEGER, AgentType TEXT, ModelType TEXT, Prototype TEXT, ParentID INTEGER, EnterDate INTEGER);",
+		"CREATE TABLE Resources (SimID TEXT, ID INTEGER, Type TEXT, TimeCreated INTEGER, Quantity REAL, units TEXT, StateId INTEGER, Parent1 INTEGER, Parent2 INTEGER);",
+		"CREATE TABLE ResCreators (SimID TEXT, ResID INTEGER, ModelID INTEGER);",
+		"CREATE TABLE Transactions (SimID TEXT, ID INTEGER, SenderID INTEGER, ReceiverID INTEGER, MarketID INTEGER, Commodity TEXT, Price REAL, Time INTEGER);",
+		"CREATE TABLE TransactedResources (SimID TEXT, TransactionID INTEGER, Position INTEGER, ResourceID INTEGER, Quantity REAL);",
+	}
+	for _, sql := range stmts {
+		if err := conn.Exec(sql); err != nil {
+			return err
+		}
+	}
+
+	if err := conn.Exec("INSERT INTO SimulationTimeInfo VALUES (?,'',2010,1,0,?);", simid, duration); err != nil {
+		return err
+	}
+	return conn.Exec("INSERT INTO Agents VALUES (?,1,'Facility','Source','source',1,0);", simid)
+}
+
+const benchDbFile = "/tmp/cyclus_inv_bench_db.sqlite"
+
+func BenchmarkWalkTmpTable(b *testing.B) { benchmarkWalk(b, false) }
+
+func BenchmarkWalkInMemory(b *testing.B) { benchmarkWalk(b, true) }
+
+// benchmarkWalk builds inventories for a generated simulation with a million
+// resources.
+func benchmarkWalk(b *testing.B, inmem bool) {
+	const simid = "bench"
+
+	if err := os.RemoveAll(benchDbFile); err != nil {
+		b.Fatal(err)
+	}
+	conn, err := Open("sqlite3", benchDbFile)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer conn.Close()
+
+	if err := writeSynthetic(conn, simid, 1000000); err != nil {
+		b.Fatal(err)
+	}
+	if err := Prepare(conn); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		if err := Clear(conn, simid); err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+
+		ctx := NewContext(conn, simid, nil)
+		ctx.InMemory = inmem
+		if err := ctx.WalkAll(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
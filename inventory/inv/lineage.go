@@ -0,0 +1,196 @@
+package inv
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	resInfoSql = `SELECT res.{Resources.ID},res.TimeCreated,res.Quantity,res.Parent1,res.Parent2,rc.{ResCreators.ModelID}
+				  FROM Resources AS res
+				  LEFT JOIN ResCreators AS rc ON rc.SimID = res.SimID AND rc.{ResCreators.ResID} = res.{Resources.ID}
+				  WHERE res.SimID = ? AND res.{Resources.ID} = ?;`
+	ownersSql = `SELECT AgentID,StartTime,EndTime,COALESCE(EndReason,'') FROM Inventories
+				  WHERE SimID = ? AND ResID = ? ORDER BY StartTime ASC, EndTime ASC;`
+)
+
+// Provenance describes a single resource in a lineage.
+type Provenance struct {
+	ResId       int
+	TimeCreated int
+	Quantity    float64
+	// Parent1 and Parent2 are the resources this one was split or combined
+	// from (zero if none).
+	Parent1 int
+	Parent2 int
+	// Root is true if the resource was created from nothing by the agent
+	// Creator (as recorded in ResCreators).
+	Root    bool
+	Creator int
+	// Owners is the resource's ownership timeline from the Inventories table.
+	Owners []*Node
+}
+
+// Lineage holds the full ancestry and descendants of a resource.
+type Lineage struct {
+	Res *Provenance
+	// Ancestors holds every resource Res was derived from, back to root
+	// resources, ordered by id.
+	Ancestors []*Provenance
+	// Descendants holds every resource derived from Res, ordered by id.
+	Descendants []*Provenance
+}
+
+// GetLineage traces resource resid in simulation simid up through its
+// parents to the root resources it came from and down through all resources
+// derived from it.  Owner timelines are taken from the Inventories table and
+// are empty if it has not been built for simid.
+func GetLineage(conn *Conn, simid string, resid int) (*Lineage, error) {
+	l := &lineageCtx{conn: conn, simid: simid}
+	if err := l.init(); err != nil {
+		return nil, err
+	}
+	defer l.close()
+
+	res, err := l.res(resid)
+	if err != nil {
+		return nil, err
+	}
+	lin := &Lineage{Res: res}
+
+	// walk up
+	seen := map[int]bool{resid: true}
+	stack := []*Provenance{res}
+	for len(stack) > 0 {
+		p := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, id := range []int{p.Parent1, p.Parent2} {
+			if id == 0 || seen[id] {
+				continue
+			}
+			seen[id] = true
+			parent, err := l.res(id)
+			if err != nil {
+				return nil, err
+			}
+			lin.Ancestors = append(lin.Ancestors, parent)
+			stack = append(stack, parent)
+		}
+	}
+
+	// walk down
+	seen = map[int]bool{resid: true}
+	ids := []int{resid}
+	for len(ids) > 0 {
+		id := ids[len(ids)-1]
+		ids = ids[:len(ids)-1]
+		kids, err := l.children(id)
+		if err != nil {
+			return nil, err
+		}
+		for _, kid := range kids {
+			if seen[kid] {
+				continue
+			}
+			seen[kid] = true
+			child, err := l.res(kid)
+			if err != nil {
+				return nil, err
+			}
+			lin.Descendants = append(lin.Descendants, child)
+			ids = append(ids, kid)
+		}
+	}
+
+	sort.Slice(lin.Ancestors, func(i, j int) bool { return lin.Ancestors[i].ResId < lin.Ancestors[j].ResId })
+	sort.Slice(lin.Descendants, func(i, j int) bool { return lin.Descendants[i].ResId < lin.Descendants[j].ResId })
+	return lin, nil
+}
+
+// lineageCtx holds the prepared statements used while tracing a lineage.
+type lineageCtx struct {
+	conn      *Conn
+	simid     string
+	resStmt   *sql.Stmt
+	kidStmt   *sql.Stmt
+	ownerStmt *sql.Stmt
+}
+
+func (l *lineageCtx) init() (err error) {
+	if l.resStmt, err = l.conn.Prepare(resInfoSql); err != nil {
+		return fmt.Errorf("simid %v: preparing resource query: %w", l.simid, err)
+	}
+	if l.kidStmt, err = l.conn.Prepare(childrenSql); err != nil {
+		return fmt.Errorf("simid %v: preparing child resource query: %w", l.simid, err)
+	}
+	if l.ownerStmt, err = l.conn.Prepare(ownersSql); err != nil {
+		return fmt.Errorf("simid %v: preparing owner query: %w", l.simid, err)
+	}
+	return nil
+}
+
+func (l *lineageCtx) close() {
+	for _, stmt := range []*sql.Stmt{l.resStmt, l.kidStmt, l.ownerStmt} {
+		if stmt != nil {
+			stmt.Close()
+		}
+	}
+}
+
+// res retrieves the details and owner timeline of resource id.
+func (l *lineageCtx) res(id int) (*Provenance, error) {
+	p := &Provenance{}
+	var creator sql.NullInt64
+	err := l.resStmt.QueryRow(l.conn.simId(l.simid), id).Scan(&p.ResId, &p.TimeCreated, &p.Quantity, &p.Parent1, &p.Parent2, &creator)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("simid %v: resid %v: no such resource", l.simid, id)
+	} else if err != nil {
+		return nil, fmt.Errorf("simid %v: resid %v: retrieving resource: %w", l.simid, id, err)
+	}
+	p.Root, p.Creator = creator.Valid, int(creator.Int64)
+
+	if p.Owners, err = l.owners(id, p.Quantity); err != nil {
+		return nil, fmt.Errorf("simid %v: resid %v: retrieving owners: %w", l.simid, id, err)
+	}
+	return p, nil
+}
+
+// owners returns the owner timeline of resource id, whose quantity is qty.
+func (l *lineageCtx) owners(id int, qty float64) (owners []*Node, err error) {
+	rows, err := l.ownerStmt.Query(l.simid, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		n := &Node{ResId: id, Quantity: qty}
+		if err := rows.Scan(&n.OwnerId, &n.StartTime, &n.EndTime, &n.EndReason); err != nil {
+			return nil, err
+		}
+		owners = append(owners, n)
+	}
+	return owners, rows.Err()
+}
+
+// children returns the ids of the resources directly derived from id.
+func (l *lineageCtx) children(id int) (kids []int, err error) {
+	rows, err := l.kidStmt.Query(l.conn.simId(l.simid), id)
+	if err != nil {
+		return nil, fmt.Errorf("simid %v: resid %v: retrieving children: %w", l.simid, id, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var kid, t int
+		var qty float64
+		if err := rows.Scan(&kid, &t, &qty); err != nil {
+			return nil, fmt.Errorf("simid %v: resid %v: retrieving children: %w", l.simid, id, err)
+		}
+		kids = append(kids, kid)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("simid %v: resid %v: retrieving children: %w", l.simid, id, err)
+	}
+	return kids, nil
+}
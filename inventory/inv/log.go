@@ -0,0 +1,89 @@
+package inv
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// Level is the verbosity of a log message, or the most verbose level a
+// Logger writes.
+type Level int
+
+const (
+	// Quiet is the level of a Logger that writes no messages.
+	Quiet Level = iota
+	// Info messages describe the main stages of building inventories.
+	Info
+	// Verbose messages describe every step taken.
+	Verbose
+)
+
+// Progress describes how far the walk of a simulation has got.
+type Progress struct {
+	Simid string
+	// RootsDone is the number of the simulation's Roots walked so far.
+	RootsDone int
+	Roots     int
+	// Resources is the number of resources walked so far.
+	Resources int
+}
+
+// Percent estimates the percentage of the walk completed from the fraction
+// of root resources walked.
+func (p Progress) Percent() float64 {
+	if p.Roots == 0 {
+		return 100
+	}
+	return 100 * float64(p.RootsDone) / float64(p.Roots)
+}
+
+// Logger receives the progress messages of preparing and walking.
+// Implementations must be safe for concurrent use by several walkers.
+type Logger interface {
+	// Logf logs a message at the given level.  The message has no trailing
+	// newline.
+	Logf(level Level, format string, args ...interface{})
+	// Progress is called after each root resource has been walked.
+	Progress(p Progress)
+}
+
+// Log is the Logger used by Prepare, Finish and other functions without a
+// Context, and by Contexts without their own Log set.  It writes to stderr so
+// progress messages can't be mixed into a program's output.
+var Log Logger = NewLogger(os.Stderr, Info)
+
+// NewLogger returns a Logger that writes messages at or below level to w.
+// Progress is written at the Info level in steps of ten percent.
+func NewLogger(w io.Writer, level Level) Logger {
+	return &stdLogger{w: w, level: level, steps: map[string]int{}}
+}
+
+type stdLogger struct {
+	mu    sync.Mutex
+	w     io.Writer
+	level Level
+	steps map[string]int
+}
+
+func (l *stdLogger) Logf(level Level, format string, args ...interface{}) {
+	if level > l.level {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	fmt.Fprintf(l.w, format+"\n", args...)
+}
+
+func (l *stdLogger) Progress(p Progress) {
+	step := int(p.Percent()) / 10
+	l.mu.Lock()
+	last, ok := l.steps[p.Simid]
+	l.steps[p.Simid] = step
+	l.mu.Unlock()
+
+	if !ok || step != last {
+		l.Logf(Info, "    %.0f%% of roots walked (%v resources)", p.Percent(), p.Resources)
+	}
+}
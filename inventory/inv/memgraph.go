@@ -0,0 +1,125 @@
+package inv
+
+import (
+	"fmt"
+	"math"
+)
+
+var (
+	graphResSql = `SELECT {Resources.ID},TimeCreated,Parent1,Parent2%v FROM Resources
+				  WHERE SimID = ? ORDER BY {Resources.ID} ASC;`
+	graphOwnerSql = `SELECT trr.ResourceID, tr.ReceiverID, tr.Time, tr.{Transactions.ID}, tr.SenderID, tr.Commodity FROM Transactions AS tr
+				  INNER JOIN {TransactedResources} AS trr ON tr.{Transactions.ID} = trr.TransactionID
+				  WHERE tr.SimID = ? AND trr.SimID = ?
+				  ORDER BY tr.Time ASC;`
+)
+
+type memRes struct {
+	id, time int
+	qty      float64
+	units    string
+	typ      string
+}
+
+// memGraph holds a simulation's entire resource heritage graph and ownership
+// history in memory for walking without per-resource queries.
+type memGraph struct {
+	children map[int][]memRes
+	owners   map[int][]OwnerChange
+}
+
+// loadGraph bulk-loads the resource heritage and ownership changes for simid
+// with a single pass over each of the relevant tables.  Resource quantities,
+// units and types are also loaded if qty is true.
+func loadGraph(conn *Conn, simid string, qty bool) (*memGraph, error) {
+	g := newMemGraph()
+
+	sql := fmt.Sprintf(graphResSql, "")
+	if qty {
+		sql = fmt.Sprintf(graphResSql, qtyCols)
+	}
+
+	if err := g.loadRes(conn, sql, simid, qty); err != nil {
+		return nil, fmt.Errorf("loading resources: %w", err)
+	}
+	if err := g.loadOwners(conn, simid); err != nil {
+		return nil, fmt.Errorf("loading transactions: %w", err)
+	}
+	return g, nil
+}
+
+// loadRes adds the children of every resource retrieved by the resource
+// query sql to the graph.
+func (g *memGraph) loadRes(conn *Conn, sql, simid string, qty bool) error {
+	rows, err := conn.Query(sql, conn.simId(simid))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var p1, p2 int
+	for rows.Next() {
+		var r memRes
+		dsts := []interface{}{&r.id, &r.time, &p1, &p2}
+		if qty {
+			dsts = append(dsts, &r.qty, &r.units, &r.typ)
+		}
+		if err := rows.Scan(dsts...); err != nil {
+			return err
+		}
+		if p1 != 0 {
+			g.children[p1] = append(g.children[p1], r)
+		}
+		if p2 != 0 && p2 != p1 {
+			g.children[p2] = append(g.children[p2], r)
+		}
+	}
+	return rows.Err()
+}
+
+// loadOwners adds the ownership changes of every resource in simid to the
+// graph.
+func (g *memGraph) loadOwners(conn *Conn, simid string) error {
+	rows, err := conn.Query(graphOwnerSql, conn.simId(simid), conn.simId(simid))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var id int
+	for rows.Next() {
+		var o OwnerChange
+		if err := rows.Scan(&id, &o.Receiver, &o.Time, &o.Tx, &o.Sender, &o.Commodity); err != nil {
+			return err
+		}
+		g.owners[id] = append(g.owners[id], o)
+	}
+	return rows.Err()
+}
+
+func newMemGraph() *memGraph {
+	return &memGraph{
+		children: map[int][]memRes{},
+		owners:   map[int][]OwnerChange{},
+	}
+}
+
+func (g *memGraph) Kids(id int) ([]*Node, error) {
+	res := g.children[id]
+	kids := make([]*Node, len(res))
+	for i, r := range res {
+		kids[i] = &Node{
+			ResId:     r.id,
+			StartTime: r.time,
+			EndTime:   math.MaxInt32,
+			Quantity:  r.qty,
+			Units:     r.units,
+			Type:      r.typ,
+		}
+	}
+	return kids, nil
+}
+
+func (g *memGraph) Owners(id int) ([]OwnerChange, error) {
+	return g.owners[id], nil
+}
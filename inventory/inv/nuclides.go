@@ -0,0 +1,93 @@
+package inv
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+var (
+	nucTableSql = "CREATE TABLE IF NOT EXISTS InventoryNuclides (SimID TEXT,ResID INTEGER,AgentID INTEGER,StartTime INTEGER,EndTime INTEGER,NucID INTEGER,Mass REAL);"
+
+	// compJoin joins inventory rows (inv) to the nuclides of their resource's
+	// composition.  Composition quantities are normalized by their total
+	// (tot) so they may be recorded either as fractions or absolute amounts.
+	// The cyclus tables are matched against the simulation id bound as ?2
+	// since they may store it differently (see Conn.simId).
+	compJoin = `INNER JOIN Resources AS res ON res.SimID = ?2 AND res.{Resources.ID} = inv.ResID
+				  INNER JOIN Compositions AS c ON c.SimID = ?2 AND c.{Compositions.ID} = res.{Resources.StateID}
+				  INNER JOIN (SELECT {Compositions.ID} AS ID,SUM({Compositions.Quantity}) AS Total FROM Compositions
+				              WHERE SimID = ?2 GROUP BY {Compositions.ID}) AS tot ON tot.ID = c.{Compositions.ID}`
+	massExpr = "res.Quantity * c.{Compositions.Quantity} / tot.Total"
+
+	buildNucSql = `INSERT INTO InventoryNuclides
+				  SELECT inv.SimID,inv.ResID,inv.AgentID,inv.StartTime,inv.EndTime,c.{Compositions.IsoID},` + massExpr + `
+				  FROM Inventories AS inv ` + compJoin + `
+				  WHERE inv.SimID = ?1;`
+
+	nucSql = `SELECT c.{Compositions.IsoID},COALESCE(SUM(` + massExpr + `),0) FROM Inventories AS inv ` + compJoin + `
+				  WHERE inv.SimID = ?1 AND inv.AgentID = ?3 AND inv.StartTime <= ?4 AND inv.EndTime > ?4
+				  GROUP BY c.{Compositions.IsoID};`
+)
+
+// BuildNuclides expands simid's inventory intervals into per-nuclide masses
+// in the InventoryNuclides table, replacing any rows it already holds for
+// simid, and records in simid's status that they have been built.  The
+// Inventories table must already have been built for simid.
+func BuildNuclides(conn *Conn, simid string) error {
+	Log.Logf(Info, "Building nuclide inventories for simid %v...", simid)
+	if err := conn.Exec("BEGIN TRANSACTION;"); err != nil {
+		return fmt.Errorf("simid %v: building nuclide inventories: %w", simid, err)
+	}
+	err := conn.Exec("DELETE FROM InventoryNuclides WHERE SimID = ?;", simid)
+	if err == nil {
+		err = conn.Exec(buildNucSql, simid, conn.simId(simid))
+	}
+	if err == nil {
+		err = conn.Exec("UPDATE InventoryStatus SET Nuclides = 1 WHERE SimID = ?;", simid)
+	}
+	if err != nil {
+		conn.Exec("ROLLBACK TRANSACTION;")
+		return fmt.Errorf("simid %v: building nuclide inventories: %w", simid, err)
+	}
+	return conn.Exec("END TRANSACTION;")
+}
+
+// Nuclides returns the total mass of each nuclide held by the given agents
+// in simulation simid at time t, keyed by nuclide id.  It is computed
+// directly from the Inventories table, which must already have been built
+// for simid.
+func Nuclides(conn *Conn, simid string, t int, agents ...int) (map[int]float64, error) {
+	stmt, err := conn.Prepare(nucSql)
+	if err != nil {
+		return nil, fmt.Errorf("simid %v: preparing nuclide query: %w", simid, err)
+	}
+	defer stmt.Close()
+
+	masses := map[int]float64{}
+	for _, agent := range agents {
+		if err := addNuclides(stmt, masses, simid, conn.simId(simid), agent, t); err != nil {
+			return nil, fmt.Errorf("simid %v: agent %v: retrieving nuclides: %w", simid, agent, err)
+		}
+	}
+	return masses, nil
+}
+
+// addNuclides adds the nuclide masses held by agent at time t to masses
+// using the prepared nuclide query stmt.  cycId is simid as bound to the
+// cyclus tables (see Conn.simId).
+func addNuclides(stmt *sql.Stmt, masses map[int]float64, simid string, cycId interface{}, agent, t int) error {
+	rows, err := stmt.Query(simid, cycId, agent, t)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var nuc int
+		var mass float64
+		if err := rows.Scan(&nuc, &mass); err != nil {
+			return err
+		}
+		masses[nuc] += mass
+	}
+	return rows.Err()
+}
@@ -0,0 +1,151 @@
+package inv
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// BusyTimeout is how long connections used for concurrent walking wait on a
+// locked database before giving up.
+const BusyTimeout = 10 * time.Minute
+
+// Writer serializes inventory inserts from several concurrently walking
+// Contexts onto a single database connection.  Rows for a given simulation
+// are written in the same order as a sequential walk; only the interleaving
+// of different simulations' batches depends on scheduling.
+type Writer struct {
+	conn *Conn
+	stmt *sql.Stmt
+	reqs chan *writeReq
+	done chan struct{}
+}
+
+type writeReq struct {
+	fn  func() error
+	err chan error
+}
+
+// NewWriter creates a writer that inserts inventory rows through conn.  conn
+// must not be used by anything else until the writer is closed.
+func NewWriter(conn *Conn) (*Writer, error) {
+	if err := conn.setBusyTimeout(BusyTimeout); err != nil {
+		return nil, fmt.Errorf("setting busy timeout: %w", err)
+	}
+	stmt, err := conn.Prepare(dumpSql)
+	if err != nil {
+		return nil, fmt.Errorf("preparing inventory insert: %w", err)
+	}
+
+	w := &Writer{
+		conn: conn,
+		stmt: stmt,
+		reqs: make(chan *writeReq),
+		done: make(chan struct{}),
+	}
+	go w.run()
+	return w, nil
+}
+
+func (w *Writer) run() {
+	for req := range w.reqs {
+		req.err <- req.fn()
+	}
+	close(w.done)
+}
+
+// Write inserts nodes as inventory rows for simid, blocking until they have
+// been committed.  The quantity columns are left null unless qty is true.
+func (w *Writer) Write(simid string, nodes []*Node, qty bool) error {
+	return w.do(func() error { return insertNodes(w.conn, w.stmt, simid, nodes, qty) })
+}
+
+// WriteFlows inserts flows as rows of the Flows table for simid, blocking
+// until they have been committed.
+func (w *Writer) WriteFlows(simid string, flows []*Flow) error {
+	return w.do(func() error { return insertFlows(w.conn, simid, flows) })
+}
+
+// WriteAnomalies inserts anomalies as into the InventoryAnomalies table for
+// simid, blocking until they have been committed.
+func (w *Writer) WriteAnomalies(simid string, as []Anomaly) error {
+	return w.do(func() error { return insertAnomalies(w.conn, simid, as) })
+}
+
+// Complete records that simid's inventories have been fully written with
+// the optional parts in b.
+func (w *Writer) Complete(simid string, b Build) error {
+	return w.do(func() error { return markComplete(w.conn, simid, b) })
+}
+
+// do runs fn on the writer's goroutine and waits for its result.
+func (w *Writer) do(fn func() error) error {
+	req := &writeReq{fn: fn, err: make(chan error, 1)}
+	w.reqs <- req
+	return <-req.err
+}
+
+// Close waits for pending writes to finish and releases the writer's
+// prepared statement.  The underlying connection is not closed.
+func (w *Writer) Close() error {
+	close(w.reqs)
+	<-w.done
+	return w.stmt.Close()
+}
+
+// WalkAllParallel builds inventories for each of simids, walking up to n
+// simulations concurrently.  Each walker reads through its own connection
+// obtained from open, and all rows are written through w.  If config is
+// non-nil, it is called on each newly created Context before walking to set
+// any options.  A failure for one simulation does not stop the others; all
+// failures are returned together.
+func WalkAllParallel(open func() (*Conn, error), w *Writer, simids []string, n int, config func(*Context)) error {
+	if n < 1 {
+		n = 1
+	}
+
+	jobs := make(chan int)
+	errs := make([]error, len(simids))
+
+	var wg sync.WaitGroup
+	for i := 0; i < n && i < len(simids); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			conn, err := open()
+			if err != nil {
+				for j := range jobs {
+					errs[j] = fmt.Errorf("simid %v: opening read connection: %w", simids[j], err)
+				}
+				return
+			}
+			defer conn.Close()
+			if err := conn.setBusyTimeout(BusyTimeout); err != nil {
+				for j := range jobs {
+					errs[j] = fmt.Errorf("simid %v: setting busy timeout: %w", simids[j], err)
+				}
+				return
+			}
+
+			for j := range jobs {
+				ctx := NewContext(conn, simids[j], nil)
+				ctx.Sink = w
+				if config != nil {
+					config(ctx)
+				}
+				errs[j] = ctx.WalkAll()
+			}
+		}()
+	}
+
+	for j := range simids {
+		jobs <- j
+	}
+	close(jobs)
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,93 @@
+package inv
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Schema is a generation of the table and column names of cyclus output.
+// The package's sql is written with the names of Schema0, marking those
+// renamed by later generations as {Table} or {Table.Column} tokens that a
+// Conn replaces with the names of its own Schema.
+type Schema int
+
+const (
+	// Schema0 is the schema of cyclus output before version 1.0, with
+	// tables such as SimulationTimeInfo, Agents, AgentDeaths and
+	// TransactedResources.
+	Schema0 Schema = iota
+	// Schema1 is the schema of cyclus 1.x output, with tables such as Info,
+	// AgentEntry and AgentExit, in which each transaction moves a single
+	// resource recorded in its own row of the Transactions table.
+	Schema1
+)
+
+func (s Schema) String() string {
+	if s == Schema1 {
+		return "1.x"
+	}
+	return "pre-1.0"
+}
+
+var (
+	// schemaNames maps the Schema0 tables and columns (as Table or
+	// Table.Column) renamed by later schemas to their new names.  Names are
+	// case insensitive, so differences in case alone aren't mapped.  Schema1
+	// has no TransactedResources table; its Transactions table serves as
+	// both.
+	schemaNames = map[Schema]map[string]string{
+		Schema1: {
+			"SimulationTimeInfo":    "Info",
+			"Agents":                "AgentEntry",
+			"Agents.ID":             "AgentId",
+			"AgentDeaths":           "AgentExit",
+			"AgentDeaths.DeathDate": "ExitTime",
+			"Resources.ID":          "ResourceId",
+			"Resources.StateID":     "QualId",
+			"ResCreators.ResID":     "ResourceId",
+			"ResCreators.ModelID":   "AgentId",
+			"Transactions.ID":       "TransactionId",
+			"TransactedResources":   "Transactions",
+			"Compositions.ID":       "QualId",
+			"Compositions.IsoID":    "NucId",
+			"Compositions.Quantity": "MassFrac",
+		},
+	}
+
+	schemaTokenRe = regexp.MustCompile(`\{(\w+(\.\w+)?)\}`)
+)
+
+// DetectSchema returns the schema of the cyclus tables in the database for
+// conn (or one attached to it), judging by the name of the table listing
+// its simulations.  Databases without cyclus tables are taken to be of
+// Schema0.
+func DetectSchema(conn *Conn) (Schema, error) {
+	ok, err := hasTable(conn, Schema1.Name("SimulationTimeInfo"))
+	if err != nil {
+		return Schema0, fmt.Errorf("detecting cyclus schema: %w", err)
+	} else if ok {
+		return Schema1, nil
+	}
+	return Schema0, nil
+}
+
+// Name returns the name in s of the Schema0 table or column name (given as
+// Table or Table.Column).
+func (s Schema) Name(name string) string {
+	if n, ok := schemaNames[s][name]; ok {
+		return n
+	}
+	return name[strings.LastIndexByte(name, '.')+1:]
+}
+
+// translate replaces each {Table} and {Table.Column} token in query with
+// the corresponding name in s.
+func (s Schema) translate(query string) string {
+	if !strings.Contains(query, "{") {
+		return query
+	}
+	return schemaTokenRe.ReplaceAllStringFunc(query, func(tok string) string {
+		return s.Name(tok[1 : len(tok)-1])
+	})
+}
@@ -0,0 +1,111 @@
+package inv
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+var (
+	seriesSql = `SELECT inv.StartTime,inv.EndTime,res.Quantity FROM Inventories AS inv
+				  INNER JOIN Resources AS res ON inv.ResID = res.{Resources.ID}
+				  WHERE inv.SimID = ? AND res.SimID = ? AND inv.AgentID = ?;`
+	protoSql    = "SELECT {Agents.ID} FROM {Agents} WHERE SimID = ? AND Prototype = ? ORDER BY {Agents.ID} ASC;"
+	durationSql = "SELECT Duration FROM {SimulationTimeInfo} WHERE SimID = ?;"
+)
+
+// Duration returns the number of timesteps in the simulation simid.
+func Duration(conn *Conn, simid string) (int, error) {
+	dur := 0
+	err := conn.QueryRow(durationSql, conn.simId(simid)).Scan(&dur)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("simid %v: no such simulation", simid)
+	} else if err != nil {
+		return 0, fmt.Errorf("simid %v: retrieving duration: %w", simid, err)
+	}
+	return dur, nil
+}
+
+// PrototypeAgents returns the ids of all agents in simulation simid built
+// from the prototype proto.
+func PrototypeAgents(conn *Conn, simid, proto string) (ids []int, err error) {
+	rows, err := conn.Query(protoSql, conn.simId(simid), proto)
+	if err != nil {
+		return nil, fmt.Errorf("simid %v: retrieving agents: %w", simid, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("simid %v: retrieving agents: %w", simid, err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("simid %v: retrieving agents: %w", simid, err)
+	}
+	return ids, nil
+}
+
+// Series returns the total quantity of resources held by the given agents in
+// simulation simid at each timestep, indexed by time.  A resource is held at
+// time t if its inventory interval satisfies StartTime <= t < EndTime.  The
+// Inventories table must already have been built for simid.
+func Series(conn *Conn, simid string, agents ...int) ([]float64, error) {
+	dur, err := Duration(conn, simid)
+	if err != nil {
+		return nil, err
+	}
+
+	// accumulate quantity changes at interval boundaries and then sum them
+	// up over time.
+	deltas := make([]float64, dur+1)
+	stmt, err := conn.Prepare(seriesSql)
+	if err != nil {
+		return nil, fmt.Errorf("simid %v: preparing inventory query: %w", simid, err)
+	}
+	defer stmt.Close()
+
+	for _, agent := range agents {
+		if err := addIntervals(stmt, deltas, simid, conn.simId(simid), agent); err != nil {
+			return nil, fmt.Errorf("simid %v: agent %v: retrieving inventory: %w", simid, agent, err)
+		}
+	}
+
+	series := make([]float64, dur)
+	tot := 0.0
+	for t := range series {
+		tot += deltas[t]
+		series[t] = tot
+	}
+	return series, nil
+}
+
+// addIntervals accumulates the quantity changes at the boundaries of agent's
+// inventory intervals into deltas using the prepared inventory query stmt.
+// Intervals are clipped to the simulation, whose duration is len(deltas)-1.
+// cycId is simid as bound to the cyclus tables (see Conn.simId).
+func addIntervals(stmt *sql.Stmt, deltas []float64, simid string, cycId interface{}, agent int) error {
+	rows, err := stmt.Query(simid, cycId, agent)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	dur := len(deltas) - 1
+	for rows.Next() {
+		var start, end int
+		var qty float64
+		if err := rows.Scan(&start, &end, &qty); err != nil {
+			return err
+		}
+		start = max(start, 0)
+		end = min(end, dur)
+		if start >= end {
+			continue
+		}
+		deltas[start] += qty
+		deltas[end] -= qty
+	}
+	return rows.Err()
+}
@@ -0,0 +1,854 @@
+package inv
+
+// rawSimSql1 holds the simulations of rawSimSql as written by cyclus 1.x
+// (Schema1), which stores simulation ids as 16 byte blobs.
+var rawSimSql1 = []string{
+	`BEGIN TRANSACTION;`,
+	`CREATE TABLE Info (SimId BLOB, Handle TEXT, InitialYear INTEGER, InitialMonth INTEGER, Duration INTEGER);`,
+	`INSERT INTO "Info" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde','',2010,1,25);`,
+	`INSERT INTO "Info" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984','',2010,1,25);`,
+	`INSERT INTO "Info" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0','',2010,1,25);`,
+	`CREATE TABLE AgentEntry (SimId BLOB, AgentId INTEGER, Kind TEXT, Spec TEXT, Prototype TEXT, ParentId INTEGER, Lifetime INTEGER, EnterTime INTEGER);`,
+	`INSERT INTO "AgentEntry" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',2,'Facility','Builder','deployer',2,-1,0);`,
+	`INSERT INTO "AgentEntry" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',3,'Market','Market','milk market',3,-1,0);`,
+	`INSERT INTO "AgentEntry" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',4,'Facility','Source','dairy source',2,-1,0);`,
+	`INSERT INTO "AgentEntry" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',5,'Facility','Sink','dairy sink',2,-1,0);`,
+	`INSERT INTO "AgentEntry" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',6,'Facility','Sink','dairy sink',2,-1,5);`,
+	`INSERT INTO "AgentEntry" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',7,'Facility','Sink','dairy sink',2,-1,10);`,
+	`INSERT INTO "AgentEntry" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',8,'Facility','Source','dairy source',2,-1,15);`,
+	`INSERT INTO "AgentEntry" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',9,'Facility','Sink','dairy sink',2,-1,20);`,
+	`INSERT INTO "AgentEntry" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',2,'Facility','Builder','deployer',2,-1,0);`,
+	`INSERT INTO "AgentEntry" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',3,'Market','Market','milk market',3,-1,0);`,
+	`INSERT INTO "AgentEntry" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',4,'Facility','Source','dairy source',2,-1,0);`,
+	`INSERT INTO "AgentEntry" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',5,'Facility','Sink','dairy sink',2,-1,0);`,
+	`INSERT INTO "AgentEntry" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',6,'Facility','Sink','dairy sink',2,-1,5);`,
+	`INSERT INTO "AgentEntry" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',7,'Facility','Sink','dairy sink',2,-1,10);`,
+	`INSERT INTO "AgentEntry" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',8,'Facility','Source','dairy source',2,-1,15);`,
+	`INSERT INTO "AgentEntry" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',9,'Facility','Sink','dairy sink',2,-1,20);`,
+	`INSERT INTO "AgentEntry" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',2,'Facility','Builder','deployer',2,-1,0);`,
+	`INSERT INTO "AgentEntry" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',3,'Market','Market','milk market',3,-1,0);`,
+	`INSERT INTO "AgentEntry" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',4,'Facility','Source','dairy source',2,-1,0);`,
+	`INSERT INTO "AgentEntry" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',5,'Facility','Sink','dairy sink',2,-1,0);`,
+	`INSERT INTO "AgentEntry" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',6,'Facility','Sink','dairy sink',2,-1,5);`,
+	`INSERT INTO "AgentEntry" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',7,'Facility','Sink','dairy sink',2,-1,10);`,
+	`INSERT INTO "AgentEntry" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',8,'Facility','Source','dairy source',2,-1,15);`,
+	`INSERT INTO "AgentEntry" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',9,'Facility','Sink','dairy sink',2,-1,20);`,
+	`CREATE TABLE Resources (SimId BLOB, ResourceId INTEGER, ObjId INTEGER, Type TEXT, TimeCreated INTEGER, Quantity REAL, Units TEXT, QualId INTEGER, Parent1 INTEGER, Parent2 INTEGER);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',1,1,'GenericResource',1,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',2,2,'GenericResource',1,50.0,'kg',0,1,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',3,3,'GenericResource',1,50.0,'kg',0,1,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',4,4,'GenericResource',2,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',5,5,'GenericResource',2,25.0,'kg',0,3,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',6,6,'GenericResource',2,25.0,'kg',0,3,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',7,7,'GenericResource',2,75.0,'kg',0,2,5);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',8,8,'GenericResource',3,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',9,9,'GenericResource',3,37.5,'kg',0,7,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',10,10,'GenericResource',3,37.5,'kg',0,7,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',11,11,'GenericResource',3,87.5,'kg',0,4,9);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',12,12,'GenericResource',4,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',13,13,'GenericResource',4,43.75,'kg',0,11,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',14,14,'GenericResource',4,43.75,'kg',0,11,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',15,15,'GenericResource',4,93.75,'kg',0,8,13);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',16,16,'GenericResource',5,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',17,17,'GenericResource',5,46.875,'kg',0,15,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',18,18,'GenericResource',5,46.875,'kg',0,15,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',19,19,'GenericResource',5,96.875,'kg',0,12,17);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',20,20,'GenericResource',6,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',21,21,'GenericResource',6,48.4375,'kg',0,19,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',22,22,'GenericResource',6,48.4375,'kg',0,19,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',23,23,'GenericResource',6,98.4375,'kg',0,16,21);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',24,24,'GenericResource',7,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',25,25,'GenericResource',7,49.2188,'kg',0,23,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',26,26,'GenericResource',7,49.2188,'kg',0,23,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',27,27,'GenericResource',8,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',28,28,'GenericResource',8,24.6094,'kg',0,25,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',29,29,'GenericResource',8,24.6094,'kg',0,25,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',30,30,'GenericResource',8,25.0,'kg',0,20,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',31,31,'GenericResource',8,25.0,'kg',0,20,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',32,32,'GenericResource',8,74.6094,'kg',0,24,28);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',33,33,'GenericResource',9,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',34,34,'GenericResource',9,37.3047,'kg',0,32,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',35,35,'GenericResource',9,37.3047,'kg',0,32,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',36,36,'GenericResource',9,12.5,'kg',0,30,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',37,37,'GenericResource',9,12.5,'kg',0,30,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',38,38,'GenericResource',9,62.5,'kg',0,27,36);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',39,39,'GenericResource',10,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',40,40,'GenericResource',10,18.6523,'kg',0,34,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',41,41,'GenericResource',10,18.6523,'kg',0,34,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',42,42,'GenericResource',10,31.25,'kg',0,38,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',43,43,'GenericResource',10,31.25,'kg',0,38,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',44,44,'GenericResource',10,68.6523,'kg',0,33,40);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',45,45,'GenericResource',11,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',46,46,'GenericResource',11,34.3262,'kg',0,44,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',47,47,'GenericResource',11,34.3262,'kg',0,44,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',48,48,'GenericResource',11,15.625,'kg',0,42,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',49,49,'GenericResource',11,15.625,'kg',0,42,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',50,50,'GenericResource',11,65.625,'kg',0,39,48);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',51,51,'GenericResource',12,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',52,52,'GenericResource',12,17.1631,'kg',0,46,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',53,53,'GenericResource',12,17.1631,'kg',0,46,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',54,54,'GenericResource',12,32.8125,'kg',0,50,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',55,55,'GenericResource',12,32.8125,'kg',0,50,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',56,56,'GenericResource',12,67.1631,'kg',0,45,52);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',57,57,'GenericResource',13,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',58,58,'GenericResource',13,33.5815,'kg',0,56,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',59,59,'GenericResource',13,33.5815,'kg',0,56,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',60,60,'GenericResource',13,16.4062,'kg',0,54,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',61,61,'GenericResource',13,16.4062,'kg',0,54,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',62,62,'GenericResource',13,66.4062,'kg',0,51,60);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',63,63,'GenericResource',14,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',64,64,'GenericResource',14,16.7908,'kg',0,58,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',65,65,'GenericResource',14,16.7908,'kg',0,58,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',66,66,'GenericResource',14,33.2031,'kg',0,62,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',67,67,'GenericResource',14,33.2031,'kg',0,62,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',68,68,'GenericResource',14,66.7908,'kg',0,57,64);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',69,69,'GenericResource',15,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',70,70,'GenericResource',15,33.3954,'kg',0,68,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',71,71,'GenericResource',15,33.3954,'kg',0,68,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',72,72,'GenericResource',15,16.6016,'kg',0,66,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',73,73,'GenericResource',15,16.6016,'kg',0,66,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',74,74,'GenericResource',15,66.6016,'kg',0,63,72);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',75,75,'GenericResource',16,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',76,76,'GenericResource',16,16.6977,'kg',0,70,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',77,77,'GenericResource',16,16.6977,'kg',0,70,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',78,78,'GenericResource',16,33.3008,'kg',0,74,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',79,79,'GenericResource',16,33.3008,'kg',0,74,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',80,80,'GenericResource',16,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',81,81,'GenericResource',16,66.6977,'kg',0,69,76);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',82,82,'GenericResource',16,83.3008,'kg',0,75,78);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',83,83,'GenericResource',16,50.0,'kg',0,80,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',84,84,'GenericResource',16,50.0,'kg',0,80,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',85,85,'GenericResource',17,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',86,86,'GenericResource',17,33.3488,'kg',0,81,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',87,87,'GenericResource',17,33.3488,'kg',0,81,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',88,88,'GenericResource',17,41.6504,'kg',0,82,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',89,89,'GenericResource',17,41.6504,'kg',0,82,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',90,90,'GenericResource',17,25.0,'kg',0,84,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',91,91,'GenericResource',17,25.0,'kg',0,84,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',92,92,'GenericResource',17,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',93,93,'GenericResource',17,50.0,'kg',0,85,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',94,94,'GenericResource',17,50.0,'kg',0,85,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',95,95,'GenericResource',17,83.3488,'kg',0,94,86);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',96,96,'GenericResource',17,91.6504,'kg',0,93,88);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',97,97,'GenericResource',17,75.0,'kg',0,83,90);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',98,98,'GenericResource',18,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',99,99,'GenericResource',18,41.6744,'kg',0,95,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',100,100,'GenericResource',18,41.6744,'kg',0,95,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',101,101,'GenericResource',18,45.8252,'kg',0,96,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',102,102,'GenericResource',18,45.8252,'kg',0,96,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',103,103,'GenericResource',18,37.5,'kg',0,97,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',104,104,'GenericResource',18,37.5,'kg',0,97,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',105,105,'GenericResource',18,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',106,106,'GenericResource',18,50.0,'kg',0,98,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',107,107,'GenericResource',18,50.0,'kg',0,98,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',108,108,'GenericResource',18,91.6744,'kg',0,107,99);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',109,109,'GenericResource',18,95.8252,'kg',0,106,101);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',110,110,'GenericResource',18,87.5,'kg',0,92,103);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',111,111,'GenericResource',19,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',112,112,'GenericResource',19,45.8372,'kg',0,108,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',113,113,'GenericResource',19,45.8372,'kg',0,108,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',114,114,'GenericResource',19,47.9126,'kg',0,109,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',115,115,'GenericResource',19,47.9126,'kg',0,109,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',116,116,'GenericResource',19,43.75,'kg',0,110,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',117,117,'GenericResource',19,43.75,'kg',0,110,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',118,118,'GenericResource',19,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',119,119,'GenericResource',19,50.0,'kg',0,111,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',120,120,'GenericResource',19,50.0,'kg',0,111,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',121,121,'GenericResource',19,95.8372,'kg',0,120,112);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',122,122,'GenericResource',19,97.9126,'kg',0,119,114);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',123,123,'GenericResource',19,93.75,'kg',0,105,116);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',124,124,'GenericResource',20,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',125,125,'GenericResource',20,47.9186,'kg',0,121,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',126,126,'GenericResource',20,47.9186,'kg',0,121,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',127,127,'GenericResource',20,48.9563,'kg',0,122,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',128,128,'GenericResource',20,48.9563,'kg',0,122,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',129,129,'GenericResource',20,46.875,'kg',0,123,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',130,130,'GenericResource',20,46.875,'kg',0,123,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',131,131,'GenericResource',20,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',132,132,'GenericResource',20,50.0,'kg',0,124,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',133,133,'GenericResource',20,50.0,'kg',0,124,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',134,134,'GenericResource',20,97.9186,'kg',0,133,125);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',135,135,'GenericResource',20,98.9563,'kg',0,132,127);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',136,136,'GenericResource',20,96.875,'kg',0,118,129);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',137,137,'GenericResource',21,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',138,138,'GenericResource',21,48.9593,'kg',0,134,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',139,139,'GenericResource',21,48.9593,'kg',0,134,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',140,140,'GenericResource',21,49.4781,'kg',0,135,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',141,141,'GenericResource',21,49.4781,'kg',0,135,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',142,142,'GenericResource',21,48.4375,'kg',0,136,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',143,143,'GenericResource',21,48.4375,'kg',0,136,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',144,144,'GenericResource',21,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',145,145,'GenericResource',21,50.0,'kg',0,137,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',146,146,'GenericResource',21,50.0,'kg',0,137,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',147,147,'GenericResource',21,98.9593,'kg',0,146,138);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',148,148,'GenericResource',21,99.4781,'kg',0,145,140);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',149,149,'GenericResource',21,98.4375,'kg',0,131,142);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',150,150,'GenericResource',22,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',151,151,'GenericResource',22,49.4797,'kg',0,147,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',152,152,'GenericResource',22,49.4797,'kg',0,147,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',153,153,'GenericResource',22,49.7391,'kg',0,148,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',154,154,'GenericResource',22,49.7391,'kg',0,148,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',155,155,'GenericResource',22,49.2188,'kg',0,149,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',156,156,'GenericResource',22,49.2188,'kg',0,149,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',157,157,'GenericResource',22,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',158,158,'GenericResource',22,50.0,'kg',0,150,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',159,159,'GenericResource',22,50.0,'kg',0,150,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',160,160,'GenericResource',22,99.4797,'kg',0,159,151);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',161,161,'GenericResource',22,99.7391,'kg',0,158,153);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',162,162,'GenericResource',22,99.2188,'kg',0,144,155);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',163,163,'GenericResource',23,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',164,164,'GenericResource',23,49.7398,'kg',0,160,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',165,165,'GenericResource',23,49.7398,'kg',0,160,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',166,166,'GenericResource',23,49.8695,'kg',0,161,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',167,167,'GenericResource',23,49.8695,'kg',0,161,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',168,168,'GenericResource',23,49.6094,'kg',0,162,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',169,169,'GenericResource',23,49.6094,'kg',0,162,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',170,170,'GenericResource',23,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',171,171,'GenericResource',23,50.0,'kg',0,163,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',172,172,'GenericResource',23,50.0,'kg',0,163,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',173,173,'GenericResource',23,99.7398,'kg',0,172,164);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',174,174,'GenericResource',23,99.8695,'kg',0,171,166);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',175,175,'GenericResource',23,99.6094,'kg',0,157,168);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',176,176,'GenericResource',24,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',177,177,'GenericResource',24,49.8699,'kg',0,173,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',178,178,'GenericResource',24,49.8699,'kg',0,173,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',179,179,'GenericResource',24,49.9348,'kg',0,174,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',180,180,'GenericResource',24,49.9348,'kg',0,174,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',181,181,'GenericResource',24,49.8047,'kg',0,175,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',182,182,'GenericResource',24,49.8047,'kg',0,175,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',183,183,'GenericResource',24,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',184,184,'GenericResource',24,50.0,'kg',0,176,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',185,185,'GenericResource',24,50.0,'kg',0,176,0);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',186,186,'GenericResource',24,99.8699,'kg',0,185,177);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',187,187,'GenericResource',24,99.9348,'kg',0,184,179);`,
+	`INSERT INTO "Resources" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',188,188,'GenericResource',24,99.8047,'kg',0,170,181);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',1,1,'GenericResource',1,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',2,2,'GenericResource',1,50.0,'kg',0,1,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',3,3,'GenericResource',1,50.0,'kg',0,1,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',4,4,'GenericResource',2,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',5,5,'GenericResource',2,25.0,'kg',0,3,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',6,6,'GenericResource',2,25.0,'kg',0,3,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',7,7,'GenericResource',2,75.0,'kg',0,2,5);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',8,8,'GenericResource',3,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',9,9,'GenericResource',3,37.5,'kg',0,7,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',10,10,'GenericResource',3,37.5,'kg',0,7,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',11,11,'GenericResource',3,87.5,'kg',0,4,9);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',12,12,'GenericResource',4,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',13,13,'GenericResource',4,43.75,'kg',0,11,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',14,14,'GenericResource',4,43.75,'kg',0,11,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',15,15,'GenericResource',4,93.75,'kg',0,8,13);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',16,16,'GenericResource',5,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',17,17,'GenericResource',5,46.875,'kg',0,15,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',18,18,'GenericResource',5,46.875,'kg',0,15,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',19,19,'GenericResource',5,96.875,'kg',0,12,17);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',20,20,'GenericResource',6,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',21,21,'GenericResource',6,48.4375,'kg',0,19,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',22,22,'GenericResource',6,48.4375,'kg',0,19,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',23,23,'GenericResource',6,98.4375,'kg',0,16,21);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',24,24,'GenericResource',7,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',25,25,'GenericResource',7,49.2188,'kg',0,23,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',26,26,'GenericResource',7,49.2188,'kg',0,23,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',27,27,'GenericResource',8,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',28,28,'GenericResource',8,24.6094,'kg',0,25,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',29,29,'GenericResource',8,24.6094,'kg',0,25,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',30,30,'GenericResource',8,25.0,'kg',0,20,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',31,31,'GenericResource',8,25.0,'kg',0,20,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',32,32,'GenericResource',8,74.6094,'kg',0,24,28);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',33,33,'GenericResource',9,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',34,34,'GenericResource',9,37.3047,'kg',0,32,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',35,35,'GenericResource',9,37.3047,'kg',0,32,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',36,36,'GenericResource',9,12.5,'kg',0,30,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',37,37,'GenericResource',9,12.5,'kg',0,30,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',38,38,'GenericResource',9,62.5,'kg',0,27,36);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',39,39,'GenericResource',10,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',40,40,'GenericResource',10,18.6523,'kg',0,34,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',41,41,'GenericResource',10,18.6523,'kg',0,34,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',42,42,'GenericResource',10,31.25,'kg',0,38,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',43,43,'GenericResource',10,31.25,'kg',0,38,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',44,44,'GenericResource',10,68.6523,'kg',0,33,40);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',45,45,'GenericResource',11,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',46,46,'GenericResource',11,34.3262,'kg',0,44,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',47,47,'GenericResource',11,34.3262,'kg',0,44,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',48,48,'GenericResource',11,15.625,'kg',0,42,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',49,49,'GenericResource',11,15.625,'kg',0,42,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',50,50,'GenericResource',11,65.625,'kg',0,39,48);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',51,51,'GenericResource',12,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',52,52,'GenericResource',12,17.1631,'kg',0,46,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',53,53,'GenericResource',12,17.1631,'kg',0,46,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',54,54,'GenericResource',12,32.8125,'kg',0,50,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',55,55,'GenericResource',12,32.8125,'kg',0,50,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',56,56,'GenericResource',12,67.1631,'kg',0,45,52);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',57,57,'GenericResource',13,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',58,58,'GenericResource',13,33.5815,'kg',0,56,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',59,59,'GenericResource',13,33.5815,'kg',0,56,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',60,60,'GenericResource',13,16.4062,'kg',0,54,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',61,61,'GenericResource',13,16.4062,'kg',0,54,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',62,62,'GenericResource',13,66.4062,'kg',0,51,60);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',63,63,'GenericResource',14,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',64,64,'GenericResource',14,16.7908,'kg',0,58,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',65,65,'GenericResource',14,16.7908,'kg',0,58,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',66,66,'GenericResource',14,33.2031,'kg',0,62,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',67,67,'GenericResource',14,33.2031,'kg',0,62,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',68,68,'GenericResource',14,66.7908,'kg',0,57,64);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',69,69,'GenericResource',15,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',70,70,'GenericResource',15,33.3954,'kg',0,68,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',71,71,'GenericResource',15,33.3954,'kg',0,68,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',72,72,'GenericResource',15,16.6016,'kg',0,66,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',73,73,'GenericResource',15,16.6016,'kg',0,66,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',74,74,'GenericResource',15,66.6016,'kg',0,63,72);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',75,75,'GenericResource',16,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',76,76,'GenericResource',16,16.6977,'kg',0,70,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',77,77,'GenericResource',16,16.6977,'kg',0,70,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',78,78,'GenericResource',16,33.3008,'kg',0,74,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',79,79,'GenericResource',16,33.3008,'kg',0,74,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',80,80,'GenericResource',16,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',81,81,'GenericResource',16,66.6977,'kg',0,69,76);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',82,82,'GenericResource',16,83.3008,'kg',0,75,78);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',83,83,'GenericResource',16,50.0,'kg',0,80,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',84,84,'GenericResource',16,50.0,'kg',0,80,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',85,85,'GenericResource',17,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',86,86,'GenericResource',17,33.3488,'kg',0,81,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',87,87,'GenericResource',17,33.3488,'kg',0,81,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',88,88,'GenericResource',17,41.6504,'kg',0,82,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',89,89,'GenericResource',17,41.6504,'kg',0,82,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',90,90,'GenericResource',17,25.0,'kg',0,84,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',91,91,'GenericResource',17,25.0,'kg',0,84,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',92,92,'GenericResource',17,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',93,93,'GenericResource',17,50.0,'kg',0,85,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',94,94,'GenericResource',17,50.0,'kg',0,85,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',95,95,'GenericResource',17,83.3488,'kg',0,94,86);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',96,96,'GenericResource',17,91.6504,'kg',0,93,88);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',97,97,'GenericResource',17,75.0,'kg',0,83,90);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',98,98,'GenericResource',18,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',99,99,'GenericResource',18,41.6744,'kg',0,95,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',100,100,'GenericResource',18,41.6744,'kg',0,95,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',101,101,'GenericResource',18,45.8252,'kg',0,96,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',102,102,'GenericResource',18,45.8252,'kg',0,96,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',103,103,'GenericResource',18,37.5,'kg',0,97,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',104,104,'GenericResource',18,37.5,'kg',0,97,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',105,105,'GenericResource',18,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',106,106,'GenericResource',18,50.0,'kg',0,98,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',107,107,'GenericResource',18,50.0,'kg',0,98,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',108,108,'GenericResource',18,91.6744,'kg',0,107,99);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',109,109,'GenericResource',18,95.8252,'kg',0,106,101);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',110,110,'GenericResource',18,87.5,'kg',0,92,103);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',111,111,'GenericResource',19,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',112,112,'GenericResource',19,45.8372,'kg',0,108,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',113,113,'GenericResource',19,45.8372,'kg',0,108,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',114,114,'GenericResource',19,47.9126,'kg',0,109,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',115,115,'GenericResource',19,47.9126,'kg',0,109,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',116,116,'GenericResource',19,43.75,'kg',0,110,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',117,117,'GenericResource',19,43.75,'kg',0,110,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',118,118,'GenericResource',19,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',119,119,'GenericResource',19,50.0,'kg',0,111,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',120,120,'GenericResource',19,50.0,'kg',0,111,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',121,121,'GenericResource',19,95.8372,'kg',0,120,112);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',122,122,'GenericResource',19,97.9126,'kg',0,119,114);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',123,123,'GenericResource',19,93.75,'kg',0,105,116);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',124,124,'GenericResource',20,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',125,125,'GenericResource',20,47.9186,'kg',0,121,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',126,126,'GenericResource',20,47.9186,'kg',0,121,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',127,127,'GenericResource',20,48.9563,'kg',0,122,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',128,128,'GenericResource',20,48.9563,'kg',0,122,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',129,129,'GenericResource',20,46.875,'kg',0,123,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',130,130,'GenericResource',20,46.875,'kg',0,123,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',131,131,'GenericResource',20,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',132,132,'GenericResource',20,50.0,'kg',0,124,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',133,133,'GenericResource',20,50.0,'kg',0,124,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',134,134,'GenericResource',20,97.9186,'kg',0,133,125);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',135,135,'GenericResource',20,98.9563,'kg',0,132,127);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',136,136,'GenericResource',20,96.875,'kg',0,118,129);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',137,137,'GenericResource',21,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',138,138,'GenericResource',21,48.9593,'kg',0,134,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',139,139,'GenericResource',21,48.9593,'kg',0,134,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',140,140,'GenericResource',21,49.4781,'kg',0,135,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',141,141,'GenericResource',21,49.4781,'kg',0,135,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',142,142,'GenericResource',21,48.4375,'kg',0,136,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',143,143,'GenericResource',21,48.4375,'kg',0,136,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',144,144,'GenericResource',21,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',145,145,'GenericResource',21,50.0,'kg',0,137,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',146,146,'GenericResource',21,50.0,'kg',0,137,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',147,147,'GenericResource',21,98.9593,'kg',0,146,138);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',148,148,'GenericResource',21,99.4781,'kg',0,145,140);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',149,149,'GenericResource',21,98.4375,'kg',0,131,142);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',150,150,'GenericResource',22,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',151,151,'GenericResource',22,49.4797,'kg',0,147,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',152,152,'GenericResource',22,49.4797,'kg',0,147,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',153,153,'GenericResource',22,49.7391,'kg',0,148,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',154,154,'GenericResource',22,49.7391,'kg',0,148,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',155,155,'GenericResource',22,49.2188,'kg',0,149,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',156,156,'GenericResource',22,49.2188,'kg',0,149,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',157,157,'GenericResource',22,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',158,158,'GenericResource',22,50.0,'kg',0,150,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',159,159,'GenericResource',22,50.0,'kg',0,150,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',160,160,'GenericResource',22,99.4797,'kg',0,159,151);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',161,161,'GenericResource',22,99.7391,'kg',0,158,153);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',162,162,'GenericResource',22,99.2188,'kg',0,144,155);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',163,163,'GenericResource',23,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',164,164,'GenericResource',23,49.7398,'kg',0,160,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',165,165,'GenericResource',23,49.7398,'kg',0,160,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',166,166,'GenericResource',23,49.8695,'kg',0,161,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',167,167,'GenericResource',23,49.8695,'kg',0,161,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',168,168,'GenericResource',23,49.6094,'kg',0,162,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',169,169,'GenericResource',23,49.6094,'kg',0,162,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',170,170,'GenericResource',23,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',171,171,'GenericResource',23,50.0,'kg',0,163,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',172,172,'GenericResource',23,50.0,'kg',0,163,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',173,173,'GenericResource',23,99.7398,'kg',0,172,164);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',174,174,'GenericResource',23,99.8695,'kg',0,171,166);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',175,175,'GenericResource',23,99.6094,'kg',0,157,168);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',176,176,'GenericResource',24,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',177,177,'GenericResource',24,49.8699,'kg',0,173,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',178,178,'GenericResource',24,49.8699,'kg',0,173,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',179,179,'GenericResource',24,49.9348,'kg',0,174,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',180,180,'GenericResource',24,49.9348,'kg',0,174,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',181,181,'GenericResource',24,49.8047,'kg',0,175,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',182,182,'GenericResource',24,49.8047,'kg',0,175,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',183,183,'GenericResource',24,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',184,184,'GenericResource',24,50.0,'kg',0,176,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',185,185,'GenericResource',24,50.0,'kg',0,176,0);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',186,186,'GenericResource',24,99.8699,'kg',0,185,177);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',187,187,'GenericResource',24,99.9348,'kg',0,184,179);`,
+	`INSERT INTO "Resources" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',188,188,'GenericResource',24,99.8047,'kg',0,170,181);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',1,1,'GenericResource',1,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',2,2,'GenericResource',1,50.0,'kg',0,1,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',3,3,'GenericResource',1,50.0,'kg',0,1,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',4,4,'GenericResource',2,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',5,5,'GenericResource',2,25.0,'kg',0,3,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',6,6,'GenericResource',2,25.0,'kg',0,3,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',7,7,'GenericResource',2,75.0,'kg',0,2,5);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',8,8,'GenericResource',3,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',9,9,'GenericResource',3,37.5,'kg',0,7,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',10,10,'GenericResource',3,37.5,'kg',0,7,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',11,11,'GenericResource',3,87.5,'kg',0,4,9);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',12,12,'GenericResource',4,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',13,13,'GenericResource',4,43.75,'kg',0,11,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',14,14,'GenericResource',4,43.75,'kg',0,11,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',15,15,'GenericResource',4,93.75,'kg',0,8,13);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',16,16,'GenericResource',5,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',17,17,'GenericResource',5,46.875,'kg',0,15,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',18,18,'GenericResource',5,46.875,'kg',0,15,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',19,19,'GenericResource',5,96.875,'kg',0,12,17);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',20,20,'GenericResource',6,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',21,21,'GenericResource',6,48.4375,'kg',0,19,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',22,22,'GenericResource',6,48.4375,'kg',0,19,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',23,23,'GenericResource',6,98.4375,'kg',0,16,21);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',24,24,'GenericResource',7,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',25,25,'GenericResource',7,49.2188,'kg',0,23,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',26,26,'GenericResource',7,49.2188,'kg',0,23,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',27,27,'GenericResource',8,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',28,28,'GenericResource',8,24.6094,'kg',0,25,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',29,29,'GenericResource',8,24.6094,'kg',0,25,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',30,30,'GenericResource',8,25.0,'kg',0,20,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',31,31,'GenericResource',8,25.0,'kg',0,20,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',32,32,'GenericResource',8,74.6094,'kg',0,24,28);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',33,33,'GenericResource',9,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',34,34,'GenericResource',9,37.3047,'kg',0,32,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',35,35,'GenericResource',9,37.3047,'kg',0,32,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',36,36,'GenericResource',9,12.5,'kg',0,30,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',37,37,'GenericResource',9,12.5,'kg',0,30,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',38,38,'GenericResource',9,62.5,'kg',0,27,36);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',39,39,'GenericResource',10,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',40,40,'GenericResource',10,18.6523,'kg',0,34,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',41,41,'GenericResource',10,18.6523,'kg',0,34,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',42,42,'GenericResource',10,31.25,'kg',0,38,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',43,43,'GenericResource',10,31.25,'kg',0,38,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',44,44,'GenericResource',10,68.6523,'kg',0,33,40);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',45,45,'GenericResource',11,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',46,46,'GenericResource',11,34.3262,'kg',0,44,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',47,47,'GenericResource',11,34.3262,'kg',0,44,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',48,48,'GenericResource',11,15.625,'kg',0,42,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',49,49,'GenericResource',11,15.625,'kg',0,42,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',50,50,'GenericResource',11,65.625,'kg',0,39,48);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',51,51,'GenericResource',12,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',52,52,'GenericResource',12,17.1631,'kg',0,46,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',53,53,'GenericResource',12,17.1631,'kg',0,46,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',54,54,'GenericResource',12,32.8125,'kg',0,50,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',55,55,'GenericResource',12,32.8125,'kg',0,50,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',56,56,'GenericResource',12,67.1631,'kg',0,45,52);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',57,57,'GenericResource',13,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',58,58,'GenericResource',13,33.5815,'kg',0,56,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',59,59,'GenericResource',13,33.5815,'kg',0,56,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',60,60,'GenericResource',13,16.4062,'kg',0,54,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',61,61,'GenericResource',13,16.4062,'kg',0,54,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',62,62,'GenericResource',13,66.4062,'kg',0,51,60);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',63,63,'GenericResource',14,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',64,64,'GenericResource',14,16.7908,'kg',0,58,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',65,65,'GenericResource',14,16.7908,'kg',0,58,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',66,66,'GenericResource',14,33.2031,'kg',0,62,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',67,67,'GenericResource',14,33.2031,'kg',0,62,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',68,68,'GenericResource',14,66.7908,'kg',0,57,64);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',69,69,'GenericResource',15,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',70,70,'GenericResource',15,33.3954,'kg',0,68,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',71,71,'GenericResource',15,33.3954,'kg',0,68,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',72,72,'GenericResource',15,16.6016,'kg',0,66,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',73,73,'GenericResource',15,16.6016,'kg',0,66,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',74,74,'GenericResource',15,66.6016,'kg',0,63,72);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',75,75,'GenericResource',16,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',76,76,'GenericResource',16,16.6977,'kg',0,70,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',77,77,'GenericResource',16,16.6977,'kg',0,70,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',78,78,'GenericResource',16,33.3008,'kg',0,74,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',79,79,'GenericResource',16,33.3008,'kg',0,74,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',80,80,'GenericResource',16,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',81,81,'GenericResource',16,66.6977,'kg',0,69,76);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',82,82,'GenericResource',16,83.3008,'kg',0,75,78);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',83,83,'GenericResource',16,50.0,'kg',0,80,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',84,84,'GenericResource',16,50.0,'kg',0,80,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',85,85,'GenericResource',17,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',86,86,'GenericResource',17,33.3488,'kg',0,81,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',87,87,'GenericResource',17,33.3488,'kg',0,81,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',88,88,'GenericResource',17,41.6504,'kg',0,82,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',89,89,'GenericResource',17,41.6504,'kg',0,82,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',90,90,'GenericResource',17,25.0,'kg',0,84,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',91,91,'GenericResource',17,25.0,'kg',0,84,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',92,92,'GenericResource',17,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',93,93,'GenericResource',17,50.0,'kg',0,85,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',94,94,'GenericResource',17,50.0,'kg',0,85,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',95,95,'GenericResource',17,83.3488,'kg',0,94,86);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',96,96,'GenericResource',17,91.6504,'kg',0,93,88);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',97,97,'GenericResource',17,75.0,'kg',0,83,90);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',98,98,'GenericResource',18,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',99,99,'GenericResource',18,41.6744,'kg',0,95,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',100,100,'GenericResource',18,41.6744,'kg',0,95,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',101,101,'GenericResource',18,45.8252,'kg',0,96,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',102,102,'GenericResource',18,45.8252,'kg',0,96,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',103,103,'GenericResource',18,37.5,'kg',0,97,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',104,104,'GenericResource',18,37.5,'kg',0,97,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',105,105,'GenericResource',18,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',106,106,'GenericResource',18,50.0,'kg',0,98,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',107,107,'GenericResource',18,50.0,'kg',0,98,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',108,108,'GenericResource',18,91.6744,'kg',0,107,99);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',109,109,'GenericResource',18,95.8252,'kg',0,106,101);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',110,110,'GenericResource',18,87.5,'kg',0,92,103);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',111,111,'GenericResource',19,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',112,112,'GenericResource',19,45.8372,'kg',0,108,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',113,113,'GenericResource',19,45.8372,'kg',0,108,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',114,114,'GenericResource',19,47.9126,'kg',0,109,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',115,115,'GenericResource',19,47.9126,'kg',0,109,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',116,116,'GenericResource',19,43.75,'kg',0,110,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',117,117,'GenericResource',19,43.75,'kg',0,110,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',118,118,'GenericResource',19,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',119,119,'GenericResource',19,50.0,'kg',0,111,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',120,120,'GenericResource',19,50.0,'kg',0,111,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',121,121,'GenericResource',19,95.8372,'kg',0,120,112);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',122,122,'GenericResource',19,97.9126,'kg',0,119,114);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',123,123,'GenericResource',19,93.75,'kg',0,105,116);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',124,124,'GenericResource',20,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',125,125,'GenericResource',20,47.9186,'kg',0,121,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',126,126,'GenericResource',20,47.9186,'kg',0,121,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',127,127,'GenericResource',20,48.9563,'kg',0,122,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',128,128,'GenericResource',20,48.9563,'kg',0,122,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',129,129,'GenericResource',20,46.875,'kg',0,123,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',130,130,'GenericResource',20,46.875,'kg',0,123,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',131,131,'GenericResource',20,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',132,132,'GenericResource',20,50.0,'kg',0,124,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',133,133,'GenericResource',20,50.0,'kg',0,124,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',134,134,'GenericResource',20,97.9186,'kg',0,133,125);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',135,135,'GenericResource',20,98.9563,'kg',0,132,127);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',136,136,'GenericResource',20,96.875,'kg',0,118,129);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',137,137,'GenericResource',21,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',138,138,'GenericResource',21,48.9593,'kg',0,134,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',139,139,'GenericResource',21,48.9593,'kg',0,134,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',140,140,'GenericResource',21,49.4781,'kg',0,135,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',141,141,'GenericResource',21,49.4781,'kg',0,135,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',142,142,'GenericResource',21,48.4375,'kg',0,136,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',143,143,'GenericResource',21,48.4375,'kg',0,136,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',144,144,'GenericResource',21,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',145,145,'GenericResource',21,50.0,'kg',0,137,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',146,146,'GenericResource',21,50.0,'kg',0,137,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',147,147,'GenericResource',21,98.9593,'kg',0,146,138);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',148,148,'GenericResource',21,99.4781,'kg',0,145,140);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',149,149,'GenericResource',21,98.4375,'kg',0,131,142);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',150,150,'GenericResource',22,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',151,151,'GenericResource',22,49.4797,'kg',0,147,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',152,152,'GenericResource',22,49.4797,'kg',0,147,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',153,153,'GenericResource',22,49.7391,'kg',0,148,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',154,154,'GenericResource',22,49.7391,'kg',0,148,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',155,155,'GenericResource',22,49.2188,'kg',0,149,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',156,156,'GenericResource',22,49.2188,'kg',0,149,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',157,157,'GenericResource',22,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',158,158,'GenericResource',22,50.0,'kg',0,150,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',159,159,'GenericResource',22,50.0,'kg',0,150,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',160,160,'GenericResource',22,99.4797,'kg',0,159,151);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',161,161,'GenericResource',22,99.7391,'kg',0,158,153);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',162,162,'GenericResource',22,99.2188,'kg',0,144,155);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',163,163,'GenericResource',23,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',164,164,'GenericResource',23,49.7398,'kg',0,160,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',165,165,'GenericResource',23,49.7398,'kg',0,160,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',166,166,'GenericResource',23,49.8695,'kg',0,161,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',167,167,'GenericResource',23,49.8695,'kg',0,161,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',168,168,'GenericResource',23,49.6094,'kg',0,162,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',169,169,'GenericResource',23,49.6094,'kg',0,162,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',170,170,'GenericResource',23,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',171,171,'GenericResource',23,50.0,'kg',0,163,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',172,172,'GenericResource',23,50.0,'kg',0,163,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',173,173,'GenericResource',23,99.7398,'kg',0,172,164);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',174,174,'GenericResource',23,99.8695,'kg',0,171,166);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',175,175,'GenericResource',23,99.6094,'kg',0,157,168);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',176,176,'GenericResource',24,100.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',177,177,'GenericResource',24,49.8699,'kg',0,173,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',178,178,'GenericResource',24,49.8699,'kg',0,173,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',179,179,'GenericResource',24,49.9348,'kg',0,174,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',180,180,'GenericResource',24,49.9348,'kg',0,174,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',181,181,'GenericResource',24,49.8047,'kg',0,175,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',182,182,'GenericResource',24,49.8047,'kg',0,175,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',183,183,'GenericResource',24,50.0,'kg',0,0,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',184,184,'GenericResource',24,50.0,'kg',0,176,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',185,185,'GenericResource',24,50.0,'kg',0,176,0);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',186,186,'GenericResource',24,99.8699,'kg',0,185,177);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',187,187,'GenericResource',24,99.9348,'kg',0,184,179);`,
+	`INSERT INTO "Resources" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',188,188,'GenericResource',24,99.8047,'kg',0,170,181);`,
+	`CREATE TABLE ResCreators (SimId BLOB, ResourceId INTEGER, AgentId INTEGER);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',1,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',4,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',8,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',12,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',16,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',20,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',24,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',27,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',33,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',39,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',45,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',51,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',57,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',63,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',69,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',75,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',80,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',85,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',92,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',98,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',105,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',111,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',118,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',124,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',131,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',137,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',144,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',150,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',157,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',163,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',170,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',176,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',183,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',1,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',4,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',8,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',12,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',16,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',20,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',24,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',27,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',33,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',39,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',45,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',51,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',57,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',63,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',69,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',75,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',80,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',85,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',92,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',98,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',105,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',111,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',118,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',124,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',131,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',137,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',144,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',150,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',157,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',163,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',170,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',176,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',183,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',1,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',4,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',8,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',12,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',16,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',20,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',24,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',27,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',33,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',39,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',45,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',51,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',57,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',63,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',69,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',75,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',80,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',85,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',92,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',98,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',105,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',111,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',118,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',124,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',131,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',137,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',144,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',150,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',157,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',163,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',170,8);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',176,4);`,
+	`INSERT INTO "ResCreators" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',183,8);`,
+	`CREATE TABLE Transactions (SimId BLOB, TransactionId INTEGER, SenderId INTEGER, ReceiverId INTEGER, ResourceId INTEGER, Commodity TEXT, Time INTEGER);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',1,4,5,3,'milk',1);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',2,4,5,2,'milk',2);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',3,4,5,4,'milk',3);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',4,4,5,8,'milk',4);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',5,4,5,12,'milk',5);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',6,4,5,16,'milk',6);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',7,4,6,20,'milk',7);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',8,4,5,24,'milk',8);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',9,4,6,27,'milk',9);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',10,4,5,33,'milk',10);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',11,4,6,39,'milk',11);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',12,4,5,45,'milk',12);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',13,4,6,51,'milk',13);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',14,4,5,57,'milk',14);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',15,4,6,63,'milk',15);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',16,4,5,69,'milk',16);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',17,4,6,75,'milk',16);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',18,8,7,84,'milk',16);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',19,4,5,94,'milk',17);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',20,4,6,93,'milk',17);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',21,8,7,83,'milk',17);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',22,4,5,107,'milk',18);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',23,4,6,106,'milk',18);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',24,8,7,92,'milk',18);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',25,4,5,120,'milk',19);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',26,4,6,119,'milk',19);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',27,8,7,105,'milk',19);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',28,4,5,133,'milk',20);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',29,4,6,132,'milk',20);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',30,8,7,118,'milk',20);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',31,4,5,146,'milk',21);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',32,4,6,145,'milk',21);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',33,8,7,131,'milk',21);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',34,4,5,159,'milk',22);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',35,4,6,158,'milk',22);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',36,8,7,144,'milk',22);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',37,4,5,172,'milk',23);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',38,4,6,171,'milk',23);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',39,8,7,157,'milk',23);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',40,4,5,185,'milk',24);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',41,4,6,184,'milk',24);`,
+	`INSERT INTO "Transactions" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',42,8,7,170,'milk',24);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',1,4,5,3,'milk',1);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',2,4,5,2,'milk',2);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',3,4,5,4,'milk',3);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',4,4,5,8,'milk',4);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',5,4,5,12,'milk',5);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',6,4,5,16,'milk',6);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',7,4,6,20,'milk',7);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',8,4,5,24,'milk',8);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',9,4,6,27,'milk',9);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',10,4,5,33,'milk',10);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',11,4,6,39,'milk',11);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',12,4,5,45,'milk',12);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',13,4,6,51,'milk',13);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',14,4,5,57,'milk',14);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',15,4,6,63,'milk',15);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',16,4,5,69,'milk',16);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',17,4,6,75,'milk',16);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',18,8,7,84,'milk',16);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',19,4,5,94,'milk',17);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',20,4,6,93,'milk',17);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',21,8,7,83,'milk',17);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',22,4,5,107,'milk',18);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',23,4,6,106,'milk',18);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',24,8,7,92,'milk',18);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',25,4,5,120,'milk',19);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',26,4,6,119,'milk',19);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',27,8,7,105,'milk',19);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',28,4,5,133,'milk',20);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',29,4,6,132,'milk',20);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',30,8,7,118,'milk',20);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',31,4,5,146,'milk',21);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',32,4,6,145,'milk',21);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',33,8,7,131,'milk',21);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',34,4,5,159,'milk',22);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',35,4,6,158,'milk',22);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',36,8,7,144,'milk',22);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',37,4,5,172,'milk',23);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',38,4,6,171,'milk',23);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',39,8,7,157,'milk',23);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',40,4,5,185,'milk',24);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',41,4,6,184,'milk',24);`,
+	`INSERT INTO "Transactions" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',42,8,7,170,'milk',24);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',1,4,5,3,'milk',1);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',2,4,5,2,'milk',2);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',3,4,5,4,'milk',3);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',4,4,5,8,'milk',4);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',5,4,5,12,'milk',5);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',6,4,5,16,'milk',6);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',7,4,6,20,'milk',7);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',8,4,5,24,'milk',8);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',9,4,6,27,'milk',9);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',10,4,5,33,'milk',10);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',11,4,6,39,'milk',11);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',12,4,5,45,'milk',12);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',13,4,6,51,'milk',13);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',14,4,5,57,'milk',14);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',15,4,6,63,'milk',15);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',16,4,5,69,'milk',16);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',17,4,6,75,'milk',16);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',18,8,7,84,'milk',16);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',19,4,5,94,'milk',17);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',20,4,6,93,'milk',17);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',21,8,7,83,'milk',17);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',22,4,5,107,'milk',18);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',23,4,6,106,'milk',18);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',24,8,7,92,'milk',18);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',25,4,5,120,'milk',19);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',26,4,6,119,'milk',19);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',27,8,7,105,'milk',19);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',28,4,5,133,'milk',20);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',29,4,6,132,'milk',20);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',30,8,7,118,'milk',20);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',31,4,5,146,'milk',21);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',32,4,6,145,'milk',21);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',33,8,7,131,'milk',21);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',34,4,5,159,'milk',22);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',35,4,6,158,'milk',22);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',36,8,7,144,'milk',22);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',37,4,5,172,'milk',23);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',38,4,6,171,'milk',23);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',39,8,7,157,'milk',23);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',40,4,5,185,'milk',24);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',41,4,6,184,'milk',24);`,
+	`INSERT INTO "Transactions" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',42,8,7,170,'milk',24);`,
+	`CREATE TABLE AgentExit (SimId BLOB, AgentId INTEGER, ExitTime INTEGER);`,
+	`INSERT INTO "AgentExit" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',2,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',4,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',5,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',6,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',7,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',8,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',9,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'07947e670c8e41a2ad8e15ecb77b4bde',3,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',2,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',4,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',5,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',6,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',7,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',8,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',9,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'f5cc4a28729f4e1cb183c624a8e94984',3,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',2,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',4,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',5,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',6,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',7,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',8,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',9,25);`,
+	`INSERT INTO "AgentExit" VALUES(X'dca7fb0db6a64738a90a88c3a312c0b0',3,25);`,
+	`COMMIT;`,
+}
@@ -1,5 +1,4 @@
-
-package main
+package inv
 
 var rawSimSql = []string{
 	`BEGIN TRANSACTION;`,
@@ -1022,33 +1021,33 @@ var inventorySql = []string{
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',164,5,23,23);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',173,5,23,24);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',177,5,24,24);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',186,5,24,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',178,5,24,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',165,5,23,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',152,5,22,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',139,5,21,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',126,5,20,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',113,5,19,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',100,5,18,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',87,5,17,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',77,5,16,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',71,5,15,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',65,5,14,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',59,5,13,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',53,5,12,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',47,5,11,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',41,5,10,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',35,5,9,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',29,5,8,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',26,5,7,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',22,5,6,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',18,5,5,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',14,5,4,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',10,5,3,2147483647);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',186,5,24,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',178,5,24,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',165,5,23,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',152,5,22,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',139,5,21,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',126,5,20,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',113,5,19,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',100,5,18,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',87,5,17,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',77,5,16,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',71,5,15,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',65,5,14,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',59,5,13,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',53,5,12,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',47,5,11,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',41,5,10,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',35,5,9,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',29,5,8,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',26,5,7,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',22,5,6,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',18,5,5,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',14,5,4,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',10,5,3,25);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',3,5,1,2);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',3,4,1,1);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',5,5,2,2);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',6,5,2,2147483647);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',6,5,2,25);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',4,5,3,3);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',4,4,2,3);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',8,5,4,4);`,
@@ -1088,24 +1087,24 @@ var inventorySql = []string{
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',166,6,23,23);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',174,6,23,24);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',179,6,24,24);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',187,6,24,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',180,6,24,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',167,6,23,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',154,6,22,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',141,6,21,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',128,6,20,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',115,6,19,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',102,6,18,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',89,6,17,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',79,6,16,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',73,6,15,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',67,6,14,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',61,6,13,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',55,6,12,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',49,6,11,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',43,6,10,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',37,6,9,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',31,6,8,2147483647);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',187,6,24,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',180,6,24,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',167,6,23,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',154,6,22,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',141,6,21,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',128,6,20,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',115,6,19,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',102,6,18,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',89,6,17,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',79,6,16,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',73,6,15,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',67,6,14,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',61,6,13,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',55,6,12,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',49,6,11,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',43,6,10,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',37,6,9,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',31,6,8,25);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',24,5,8,8);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',24,4,7,8);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',27,6,9,9);`,
@@ -1143,18 +1142,18 @@ var inventorySql = []string{
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',168,7,23,23);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',175,7,23,24);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',181,7,24,24);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',188,7,24,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',182,7,24,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',169,7,23,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',156,7,22,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',143,7,21,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',130,7,20,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',117,7,19,2147483647);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',104,7,18,2147483647);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',188,7,24,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',182,7,24,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',169,7,23,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',156,7,22,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',143,7,21,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',130,7,20,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',117,7,19,25);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',104,7,18,25);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',84,7,16,17);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',84,8,16,16);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',90,7,17,17);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',91,7,17,2147483647);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',91,7,17,25);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',85,4,17,17);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',93,6,17,17);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',93,4,17,17);`,
@@ -1209,7 +1208,7 @@ var inventorySql = []string{
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',184,4,24,24);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',185,5,24,24);`,
 	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',185,4,24,24);`,
-	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',183,8,24,2147483647);`,
+	`INSERT INTO Inventories VALUES('07947e67-0c8e-41a2-ad8e-15ecb77b4bde',183,8,24,25);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',1,4,1,1);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',2,5,2,2);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',2,4,1,2);`,
@@ -1252,33 +1251,33 @@ var inventorySql = []string{
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',164,5,23,23);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',173,5,23,24);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',177,5,24,24);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',186,5,24,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',178,5,24,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',165,5,23,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',152,5,22,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',139,5,21,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',126,5,20,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',113,5,19,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',100,5,18,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',87,5,17,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',77,5,16,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',71,5,15,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',65,5,14,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',59,5,13,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',53,5,12,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',47,5,11,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',41,5,10,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',35,5,9,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',29,5,8,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',26,5,7,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',22,5,6,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',18,5,5,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',14,5,4,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',10,5,3,2147483647);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',186,5,24,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',178,5,24,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',165,5,23,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',152,5,22,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',139,5,21,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',126,5,20,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',113,5,19,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',100,5,18,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',87,5,17,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',77,5,16,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',71,5,15,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',65,5,14,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',59,5,13,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',53,5,12,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',47,5,11,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',41,5,10,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',35,5,9,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',29,5,8,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',26,5,7,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',22,5,6,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',18,5,5,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',14,5,4,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',10,5,3,25);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',3,5,1,2);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',3,4,1,1);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',5,5,2,2);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',6,5,2,2147483647);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',6,5,2,25);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',4,5,3,3);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',4,4,2,3);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',8,5,4,4);`,
@@ -1318,24 +1317,24 @@ var inventorySql = []string{
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',166,6,23,23);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',174,6,23,24);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',179,6,24,24);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',187,6,24,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',180,6,24,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',167,6,23,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',154,6,22,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',141,6,21,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',128,6,20,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',115,6,19,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',102,6,18,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',89,6,17,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',79,6,16,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',73,6,15,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',67,6,14,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',61,6,13,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',55,6,12,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',49,6,11,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',43,6,10,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',37,6,9,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',31,6,8,2147483647);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',187,6,24,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',180,6,24,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',167,6,23,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',154,6,22,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',141,6,21,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',128,6,20,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',115,6,19,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',102,6,18,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',89,6,17,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',79,6,16,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',73,6,15,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',67,6,14,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',61,6,13,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',55,6,12,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',49,6,11,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',43,6,10,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',37,6,9,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',31,6,8,25);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',24,5,8,8);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',24,4,7,8);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',27,6,9,9);`,
@@ -1373,18 +1372,18 @@ var inventorySql = []string{
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',168,7,23,23);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',175,7,23,24);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',181,7,24,24);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',188,7,24,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',182,7,24,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',169,7,23,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',156,7,22,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',143,7,21,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',130,7,20,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',117,7,19,2147483647);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',104,7,18,2147483647);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',188,7,24,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',182,7,24,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',169,7,23,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',156,7,22,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',143,7,21,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',130,7,20,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',117,7,19,25);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',104,7,18,25);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',84,7,16,17);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',84,8,16,16);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',90,7,17,17);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',91,7,17,2147483647);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',91,7,17,25);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',85,4,17,17);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',93,6,17,17);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',93,4,17,17);`,
@@ -1439,7 +1438,7 @@ var inventorySql = []string{
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',184,4,24,24);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',185,5,24,24);`,
 	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',185,4,24,24);`,
-	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',183,8,24,2147483647);`,
+	`INSERT INTO Inventories VALUES('f5cc4a28-729f-4e1c-b183-c624a8e94984',183,8,24,25);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',1,4,1,1);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',2,5,2,2);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',2,4,1,2);`,
@@ -1482,33 +1481,33 @@ var inventorySql = []string{
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',164,5,23,23);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',173,5,23,24);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',177,5,24,24);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',186,5,24,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',178,5,24,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',165,5,23,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',152,5,22,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',139,5,21,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',126,5,20,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',113,5,19,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',100,5,18,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',87,5,17,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',77,5,16,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',71,5,15,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',65,5,14,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',59,5,13,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',53,5,12,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',47,5,11,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',41,5,10,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',35,5,9,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',29,5,8,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',26,5,7,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',22,5,6,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',18,5,5,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',14,5,4,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',10,5,3,2147483647);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',186,5,24,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',178,5,24,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',165,5,23,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',152,5,22,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',139,5,21,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',126,5,20,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',113,5,19,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',100,5,18,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',87,5,17,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',77,5,16,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',71,5,15,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',65,5,14,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',59,5,13,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',53,5,12,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',47,5,11,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',41,5,10,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',35,5,9,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',29,5,8,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',26,5,7,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',22,5,6,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',18,5,5,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',14,5,4,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',10,5,3,25);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',3,5,1,2);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',3,4,1,1);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',5,5,2,2);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',6,5,2,2147483647);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',6,5,2,25);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',4,5,3,3);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',4,4,2,3);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',8,5,4,4);`,
@@ -1548,24 +1547,24 @@ var inventorySql = []string{
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',166,6,23,23);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',174,6,23,24);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',179,6,24,24);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',187,6,24,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',180,6,24,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',167,6,23,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',154,6,22,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',141,6,21,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',128,6,20,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',115,6,19,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',102,6,18,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',89,6,17,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',79,6,16,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',73,6,15,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',67,6,14,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',61,6,13,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',55,6,12,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',49,6,11,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',43,6,10,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',37,6,9,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',31,6,8,2147483647);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',187,6,24,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',180,6,24,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',167,6,23,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',154,6,22,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',141,6,21,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',128,6,20,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',115,6,19,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',102,6,18,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',89,6,17,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',79,6,16,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',73,6,15,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',67,6,14,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',61,6,13,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',55,6,12,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',49,6,11,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',43,6,10,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',37,6,9,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',31,6,8,25);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',24,5,8,8);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',24,4,7,8);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',27,6,9,9);`,
@@ -1603,18 +1602,18 @@ var inventorySql = []string{
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',168,7,23,23);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',175,7,23,24);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',181,7,24,24);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',188,7,24,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',182,7,24,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',169,7,23,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',156,7,22,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',143,7,21,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',130,7,20,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',117,7,19,2147483647);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',104,7,18,2147483647);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',188,7,24,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',182,7,24,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',169,7,23,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',156,7,22,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',143,7,21,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',130,7,20,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',117,7,19,25);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',104,7,18,25);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',84,7,16,17);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',84,8,16,16);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',90,7,17,17);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',91,7,17,2147483647);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',91,7,17,25);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',85,4,17,17);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',93,6,17,17);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',93,4,17,17);`,
@@ -1669,5 +1668,5 @@ var inventorySql = []string{
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',184,4,24,24);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',185,5,24,24);`,
 	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',185,4,24,24);`,
-	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',183,8,24,2147483647);`,
+	`INSERT INTO Inventories VALUES('dca7fb0d-b6a6-4738-a90a-88c3a312c0b0',183,8,24,25);`,
 }
@@ -0,0 +1,91 @@
+package inv
+
+import (
+	"fmt"
+	"time"
+)
+
+// Version identifies the format of the inventory rows produced by this
+// package.  Simulations whose inventories were completed by a different
+// version are considered stale and are rebuilt.
+const Version = "3"
+
+var (
+	statusTableSql = "CREATE TABLE IF NOT EXISTS InventoryStatus (SimID TEXT,Version TEXT,Completed INTEGER,Quantities INTEGER,Flows INTEGER,Nuclides INTEGER);"
+	doneSql        = `SELECT SimID FROM InventoryStatus WHERE Version = ? AND Completed IS NOT NULL
+				  AND (? = 0 OR Quantities = 1) AND (? = 0 OR Flows = 1) AND (? = 0 OR Nuclides = 1);`
+	// statusCols are the columns of the InventoryStatus table that were
+	// added after its original three; Prepare adds them to tables built by
+	// older versions.
+	statusCols = []string{"Quantities INTEGER", "Flows INTEGER", "Nuclides INTEGER"}
+)
+
+// Build describes the optional parts of a simulation's inventories.
+type Build struct {
+	// Quantities is set if inventory rows hold resource quantities, units
+	// and types (see Context.Quantities).
+	Quantities bool
+	// Flows is set if the Flows table is built (see Context.Flows).
+	Flows bool
+	// Nuclides is set if the InventoryNuclides table is built (see
+	// BuildNuclides).
+	Nuclides bool
+}
+
+// Pending returns the simulation ids in simids whose inventories have not
+// been completely built by this version of the package with at least the
+// parts in want, preserving their order.  These are new simulations, ones
+// whose previous build failed or was interrupted, ones built by an older
+// version and ones built without some of the parts now wanted.
+func Pending(conn *Conn, simids []string, want Build) (pending []string, err error) {
+	done := map[string]bool{}
+	rows, err := conn.Query(doneSql, Version, sqlBool(want.Quantities), sqlBool(want.Flows), sqlBool(want.Nuclides))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving inventory status: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var s string
+		if err := rows.Scan(&s); err != nil {
+			return nil, fmt.Errorf("retrieving inventory status: %w", err)
+		}
+		done[s] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("retrieving inventory status: %w", err)
+	}
+
+	for _, simid := range simids {
+		if !done[simid] {
+			pending = append(pending, simid)
+		}
+	}
+	return pending, nil
+}
+
+// markComplete records in conn that simid's inventories have been fully
+// built by this version of the package with the parts in b.
+func markComplete(conn *Conn, simid string, b Build) error {
+	if err := conn.Exec("BEGIN TRANSACTION;"); err != nil {
+		return fmt.Errorf("recording inventory status: %w", err)
+	}
+	err := conn.Exec("DELETE FROM InventoryStatus WHERE SimID = ?;", simid)
+	if err == nil {
+		sql := "INSERT INTO InventoryStatus (SimID,Version,Completed,Quantities,Flows,Nuclides) VALUES (?,?,?,?,?,?);"
+		err = conn.Exec(sql, simid, Version, time.Now().Unix(), sqlBool(b.Quantities), sqlBool(b.Flows), sqlBool(b.Nuclides))
+	}
+	if err != nil {
+		conn.Exec("ROLLBACK TRANSACTION;")
+		return fmt.Errorf("recording inventory status: %w", err)
+	}
+	return conn.Exec("END TRANSACTION;")
+}
+
+// sqlBool returns b as the integer it is stored as in the InventoryStatus
+// table.
+func sqlBool(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
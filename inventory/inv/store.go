@@ -0,0 +1,381 @@
+package inv
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Source provides the resource heritage and ownership history of a single
+// simulation to a walking Context.
+type Source interface {
+	// Roots returns the resources created from nothing, each owned by its
+	// creator and with an EndTime of math.MaxInt32.
+	Roots() ([]*Node, error)
+	// Kids returns the resources created directly from resource id, with
+	// an EndTime of math.MaxInt32, in the order they should be walked.
+	Kids(id int) ([]*Node, error)
+	// Owners returns the transfers of resource id in time order.
+	Owners(id int) ([]OwnerChange, error)
+	// Duration returns the number of timesteps in the simulation.
+	Duration() (int, error)
+	// Deaths returns the decommissioning time of each agent that was
+	// decommissioned.
+	Deaths() (map[int]int, error)
+}
+
+// OwnerChange is a single transfer of a resource between agents.
+type OwnerChange struct {
+	Tx        int
+	Time      int
+	Sender    int
+	Receiver  int
+	Commodity string
+}
+
+// Sink receives the inventory intervals and flows produced by walking.
+type Sink interface {
+	// Write stores nodes as inventory intervals of simid.  Their
+	// quantities, units and types are only meaningful if qty is true.
+	Write(simid string, nodes []*Node, qty bool) error
+	// WriteFlows stores the transaction flows of simid.
+	WriteFlows(simid string, flows []*Flow) error
+	// Complete records that everything for simid has been written, with
+	// the optional parts in b.
+	Complete(simid string, b Build) error
+}
+
+// anomalySink is implemented by Sinks that can also record the anomalies
+// found by validation.
+type anomalySink interface {
+	WriteAnomalies(simid string, as []Anomaly) error
+}
+
+// sqlSource is the Source for a simulation in a cyclus database.
+// Unless the whole graph is loaded into memory up front, children are
+// queried from a connection-local temporary copy of the simulation's
+// resources and owners are queried per resource.
+type sqlSource struct {
+	conn       *Conn
+	simid      string
+	qty        bool
+	log        Logger
+	mem        *memGraph
+	tmpResTbl  string
+	tmpResStmt *sql.Stmt
+	ownerStmt  *sql.Stmt
+}
+
+// openSQLSource prepares to read simulation simid from conn, loading its
+// entire graph into memory if inMemory is true.  Resource quantities, units
+// and types are retrieved if qty is true.  The source must be closed to drop
+// any temporary table it created.
+func openSQLSource(conn *Conn, simid string, inMemory, qty bool, log Logger) (s *sqlSource, err error) {
+	s = &sqlSource{conn: conn, simid: simid, qty: qty, log: log}
+	if inMemory {
+		log.Logf(Verbose, "Loading resource graph into memory...")
+		if s.mem, err = loadGraph(conn, simid, qty); err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+	if err := s.initTmpTable(); err != nil {
+		s.close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *sqlSource) initTmpTable() (err error) {
+	// create temp res table without simid - it is a connection-local TEMP
+	// table so concurrent walkers on other connections don't contend for it.
+	s.log.Logf(Verbose, "Creating temporary resource table...")
+	s.tmpResTbl = "tmp_restbl_" + strings.Replace(s.simid, "-", "_", -1)
+	if err := s.conn.Exec("DROP TABLE IF EXISTS " + s.tmpResTbl); err != nil {
+		return fmt.Errorf("dropping stale temporary resource table: %w", err)
+	}
+
+	// the table is created empty and then filled since not all databases
+	// accept parameters in CREATE TABLE ... AS.  Its id column is named ID
+	// whatever the cyclus schema.
+	cols := "{Resources.ID} AS ID,TimeCreated,Parent1,Parent2" + s.qtyCols()
+	if err := s.conn.Exec("CREATE TEMP TABLE " + s.tmpResTbl + " AS SELECT " + cols + " FROM Resources LIMIT 0;"); err != nil {
+		return fmt.Errorf("creating temporary resource table: %w", err)
+	}
+	if err := s.conn.Exec("INSERT INTO "+s.tmpResTbl+" SELECT "+cols+" FROM Resources WHERE SimID = ?;", s.conn.simId(s.simid)); err != nil {
+		return fmt.Errorf("filling temporary resource table: %w", err)
+	}
+
+	s.log.Logf(Verbose, "Indexing temporary resource table...")
+	if err := s.conn.Exec(Index(s.tmpResTbl, "Parent1")); err != nil {
+		return fmt.Errorf("indexing temporary resource table: %w", err)
+	}
+	if err := s.conn.Exec(Index(s.tmpResTbl, "Parent2")); err != nil {
+		return fmt.Errorf("indexing temporary resource table: %w", err)
+	}
+
+	// create prepared statements
+	if s.tmpResStmt, err = s.conn.Prepare(fmt.Sprintf(resSqlHead, s.qtyCols()) + s.tmpResTbl + resSqlTail); err != nil {
+		return fmt.Errorf("preparing child resource query: %w", err)
+	}
+	if s.ownerStmt, err = s.conn.Prepare(ownerSql); err != nil {
+		return fmt.Errorf("preparing owner query: %w", err)
+	}
+	return nil
+}
+
+// close releases the source's prepared statements and drops the temporary
+// resource table if one was created.
+func (s *sqlSource) close() error {
+	s.mem = nil
+	for _, stmt := range []*sql.Stmt{s.tmpResStmt, s.ownerStmt} {
+		if stmt != nil {
+			stmt.Close()
+		}
+	}
+	s.tmpResStmt, s.ownerStmt = nil, nil
+	if s.tmpResTbl == "" {
+		return nil
+	}
+	s.log.Logf(Verbose, "Dropping temporary resource table...")
+	if err := s.conn.Exec("DROP TABLE IF EXISTS " + s.tmpResTbl); err != nil {
+		return fmt.Errorf("dropping temporary resource table: %w", err)
+	}
+	s.tmpResTbl = ""
+	return nil
+}
+
+// qtyCols returns the extra resource columns to retrieve.
+func (s *sqlSource) qtyCols() string {
+	if s.qty {
+		return qtyCols
+	}
+	return ""
+}
+
+// scanRes scans the current row of rows into n, where the row holds the
+// resource columns id and time followed by any extra columns listed by
+// qtyCols.  Columns in between (e.g. the owner of root resources) are
+// scanned into the additional dsts.
+func (s *sqlSource) scanRes(rows *sql.Rows, n *Node, dsts ...interface{}) error {
+	dsts = append([]interface{}{&n.ResId, &n.StartTime}, dsts...)
+	if s.qty {
+		dsts = append(dsts, &n.Quantity, &n.Units, &n.Type)
+	}
+	return rows.Scan(dsts...)
+}
+
+func (s *sqlSource) Roots() (roots []*Node, err error) {
+	n := 0
+	err = s.conn.QueryRow("SELECT COUNT(*) FROM ResCreators WHERE SimID = ?", s.conn.simId(s.simid)).Scan(&n)
+	if err != nil {
+		return nil, fmt.Errorf("counting root resources: %w", err)
+	}
+
+	query := fmt.Sprintf(rootsSql, strings.Replace(s.qtyCols(), ",", ",res.", -1))
+	rows, err := s.conn.Query(query, s.conn.simId(s.simid), s.conn.simId(s.simid))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving root resources: %w", err)
+	}
+	defer rows.Close()
+
+	roots = make([]*Node, 0, n)
+	for rows.Next() {
+		node := &Node{EndTime: math.MaxInt32}
+		if err := s.scanRes(rows, node, &node.OwnerId); err != nil {
+			return nil, fmt.Errorf("retrieving root resources: %w", err)
+		}
+		roots = append(roots, node)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("retrieving root resources: %w", err)
+	}
+	return roots, nil
+}
+
+func (s *sqlSource) Kids(id int) (kids []*Node, err error) {
+	if s.mem != nil {
+		return s.mem.Kids(id)
+	}
+
+	rows, err := s.tmpResStmt.Query(id, id)
+	if err != nil {
+		return nil, fmt.Errorf("resid %v: retrieving children: %w", id, err)
+	}
+	defer rows.Close()
+
+	kids = make([]*Node, 0, 2)
+	for rows.Next() {
+		child := &Node{EndTime: math.MaxInt32}
+		if err := s.scanRes(rows, child); err != nil {
+			return nil, fmt.Errorf("resid %v: retrieving children: %w", id, err)
+		}
+		kids = append(kids, child)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("resid %v: retrieving children: %w", id, err)
+	}
+	return kids, nil
+}
+
+func (s *sqlSource) Owners(id int) (owners []OwnerChange, err error) {
+	if s.mem != nil {
+		return s.mem.Owners(id)
+	}
+
+	rows, err := s.ownerStmt.Query(id, s.conn.simId(s.simid), s.conn.simId(s.simid))
+	if err != nil {
+		return nil, fmt.Errorf("resid %v: retrieving owners: %w", id, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var o OwnerChange
+		if err := rows.Scan(&o.Receiver, &o.Time, &o.Tx, &o.Sender, &o.Commodity); err != nil {
+			return nil, fmt.Errorf("resid %v: retrieving owners: %w", id, err)
+		}
+		owners = append(owners, o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("resid %v: retrieving owners: %w", id, err)
+	}
+	return owners, nil
+}
+
+func (s *sqlSource) Duration() (int, error) { return Duration(s.conn, s.simid) }
+
+func (s *sqlSource) Deaths() (map[int]int, error) { return agentDeaths(s.conn, s.simid) }
+
+// dbSink writes walk results directly through a connection.
+type dbSink struct {
+	conn *Conn
+	stmt *sql.Stmt
+}
+
+func newDBSink(conn *Conn) (*dbSink, error) {
+	stmt, err := conn.Prepare(dumpSql)
+	if err != nil {
+		return nil, fmt.Errorf("preparing inventory insert: %w", err)
+	}
+	return &dbSink{conn: conn, stmt: stmt}, nil
+}
+
+func (s *dbSink) Write(simid string, nodes []*Node, qty bool) error {
+	return insertNodes(s.conn, s.stmt, simid, nodes, qty)
+}
+
+func (s *dbSink) WriteFlows(simid string, flows []*Flow) error {
+	return insertFlows(s.conn, simid, flows)
+}
+
+func (s *dbSink) WriteAnomalies(simid string, as []Anomaly) error {
+	return insertAnomalies(s.conn, simid, as)
+}
+
+func (s *dbSink) Complete(simid string, b Build) error { return markComplete(s.conn, simid, b) }
+
+func (s *dbSink) close() error { return s.stmt.Close() }
+
+// MemStore is an in-memory Source and Sink for a single simulation, useful
+// for walking hand-built resource graphs without a cyclus database.  Build
+// the graph with the Add methods, walk it with a Context using the store as
+// both its Source and Sink, then inspect the recorded results.
+type MemStore struct {
+	graph    *memGraph
+	roots    []Node
+	duration int
+	deaths   map[int]int
+
+	// Intervals holds the inventory intervals written, in write order.
+	Intervals []Node
+	// Flows holds the transaction flows written.
+	Flows []*Flow
+	// Anomalies holds the resource graph anomalies written.
+	Anomalies []Anomaly
+	// Completed holds the simulation ids marked complete.
+	Completed []string
+}
+
+// NewMemStore creates an empty store for a simulation lasting duration
+// timesteps.
+func NewMemStore(duration int) *MemStore {
+	return &MemStore{
+		graph:    newMemGraph(),
+		duration: duration,
+		deaths:   map[int]int{},
+	}
+}
+
+// AddRoot adds resource id of quantity qty, created from nothing by agent
+// creator at time t.
+func (s *MemStore) AddRoot(id, t, creator int, qty float64) {
+	s.roots = append(s.roots, Node{ResId: id, OwnerId: creator, StartTime: t, EndTime: math.MaxInt32, Quantity: qty})
+}
+
+// AddResource adds resource id of quantity qty, created at time t from the
+// given parent resources.  Children of the same parent are walked in the
+// order they are added.
+func (s *MemStore) AddResource(id, t int, qty float64, parents ...int) {
+	r := memRes{id: id, time: t, qty: qty}
+	for i, p := range parents {
+		if i > 0 && p == parents[i-1] {
+			continue
+		}
+		s.graph.children[p] = append(s.graph.children[p], r)
+	}
+}
+
+// AddTransfer records that resource id was transferred from agent sender to
+// agent receiver at time t by transaction tx for commodity.
+func (s *MemStore) AddTransfer(id, tx, t, sender, receiver int, commodity string) {
+	owners := s.graph.owners[id]
+	i := sort.Search(len(owners), func(i int) bool { return owners[i].Time > t })
+	owners = append(owners, OwnerChange{})
+	copy(owners[i+1:], owners[i:])
+	owners[i] = OwnerChange{Tx: tx, Time: t, Sender: sender, Receiver: receiver, Commodity: commodity}
+	s.graph.owners[id] = owners
+}
+
+// AddDeath records that agent was decommissioned at time t.
+func (s *MemStore) AddDeath(agent, t int) { s.deaths[agent] = t }
+
+func (s *MemStore) Roots() ([]*Node, error) {
+	roots := make([]*Node, len(s.roots))
+	for i := range s.roots {
+		n := s.roots[i]
+		roots[i] = &n
+	}
+	return roots, nil
+}
+
+func (s *MemStore) Kids(id int) ([]*Node, error) { return s.graph.Kids(id) }
+
+func (s *MemStore) Owners(id int) ([]OwnerChange, error) { return s.graph.Owners(id) }
+
+func (s *MemStore) Duration() (int, error) { return s.duration, nil }
+
+func (s *MemStore) Deaths() (map[int]int, error) { return s.deaths, nil }
+
+func (s *MemStore) Write(simid string, nodes []*Node, qty bool) error {
+	for _, n := range nodes {
+		s.Intervals = append(s.Intervals, *n)
+	}
+	return nil
+}
+
+func (s *MemStore) WriteFlows(simid string, flows []*Flow) error {
+	s.Flows = append(s.Flows, flows...)
+	return nil
+}
+
+func (s *MemStore) WriteAnomalies(simid string, as []Anomaly) error {
+	s.Anomalies = append(s.Anomalies, as...)
+	return nil
+}
+
+func (s *MemStore) Complete(simid string, b Build) error {
+	s.Completed = append(s.Completed, simid)
+	return nil
+}
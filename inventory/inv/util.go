@@ -0,0 +1,118 @@
+package inv
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Index builds an sql statement for creating a new index on the specified
+// table over cols.  The index is named according to the table and cols.
+func Index(table string, cols ...string) string {
+	var buf bytes.Buffer
+	buf.WriteString("CREATE INDEX IF NOT EXISTS ")
+	buf.WriteString(indexName(table, cols...))
+	buf.WriteString(" ON " + table + " (" + cols[0] + " ASC")
+	for _, c := range cols[1:] {
+		buf.WriteString("," + c + " ASC")
+	}
+	buf.WriteString(");")
+	return buf.String()
+}
+
+// indexName returns the name Index gives an index on table over cols.
+func indexName(table string, cols ...string) string {
+	return table + "_" + strings.Join(cols, "_")
+}
+
+// GetSimIds returns a list of all simulation ids in the cyclus database for
+// conn.  Ids stored as 16 byte blobs are returned in the usual text form of
+// uuids.
+func GetSimIds(conn *Conn) (ids []string, err error) {
+	sql := "SELECT SimID FROM {SimulationTimeInfo}"
+	rows, err := conn.Query(sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var b []byte
+		if err := rows.Scan(&b); err != nil {
+			return nil, err
+		}
+		if conn.blobIds && len(b) == 16 {
+			ids = append(ids, formatUUID(b))
+		} else {
+			ids = append(ids, string(b))
+		}
+	}
+	return ids, rows.Err()
+}
+
+// formatUUID returns the text form of the 16 byte uuid b.
+func formatUUID(b []byte) string {
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// parseUUID returns the 16 bytes of the uuid s, given in text form.
+func parseUUID(s string) ([]byte, error) {
+	b, err := hex.DecodeString(strings.Replace(s, "-", "", -1))
+	if err != nil || len(b) != 16 {
+		return nil, fmt.Errorf("invalid uuid %q", s)
+	}
+	return b, nil
+}
+
+// FilterSimIds returns the simulation ids in simids that begin with any of
+// the given prefixes, preserving their order.  A full simulation id is its
+// own prefix.  An error is returned if any prefix matches nothing.
+func FilterSimIds(simids []string, prefixes ...string) ([]string, error) {
+	matched := make([]bool, len(simids))
+	for _, p := range prefixes {
+		found := false
+		for i, id := range simids {
+			if strings.HasPrefix(id, p) {
+				matched[i] = true
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("no simulation id matching %q", p)
+		}
+	}
+
+	var ids []string
+	for i, id := range simids {
+		if matched[i] {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+type Timer struct {
+	starts map[string]time.Time
+	Totals map[string]time.Duration
+}
+
+func NewTimer() *Timer {
+	return &Timer{
+		map[string]time.Time{},
+		map[string]time.Duration{},
+	}
+}
+
+func (t *Timer) Start(label string) {
+	if _, ok := t.starts[label]; !ok {
+		t.starts[label] = time.Now()
+	}
+}
+
+func (t *Timer) Stop(label string) {
+	if start, ok := t.starts[label]; ok {
+		t.Totals[label] += time.Now().Sub(start)
+	}
+	delete(t.starts, label)
+}
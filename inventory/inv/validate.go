@@ -0,0 +1,250 @@
+package inv
+
+import (
+	"fmt"
+	"sort"
+)
+
+var (
+	anomalyTableSql = "CREATE TABLE IF NOT EXISTS InventoryAnomalies (SimID TEXT,ResID INTEGER,Time INTEGER,Kind TEXT,Detail TEXT);"
+	anomalyDumpSql  = "INSERT INTO InventoryAnomalies VALUES (?,?,?,?,?);"
+	anomalySql      = "SELECT ResID,Time,Kind,Detail FROM InventoryAnomalies WHERE SimID = ? ORDER BY ResID ASC, Kind ASC;"
+	validTxSql      = `SELECT trr.ResourceID, tr.Time FROM Transactions AS tr
+				  INNER JOIN {TransactedResources} AS trr ON tr.{Transactions.ID} = trr.TransactionID
+				  WHERE tr.SimID = ?1 AND trr.SimID = ?1
+				  ORDER BY tr.Time ASC;`
+)
+
+// Anomaly kinds reported by Validate.  Each describes data that the walker
+// assumes can't happen and for which it silently records wrong intervals.
+const (
+	// Cycle means a resource is its own ancestor.  The walker only visits
+	// each resource once, so the cycle is cut at an arbitrary point.
+	Cycle = "cycle"
+	// ChildBeforeParent means a resource was created before one of its
+	// parents.
+	ChildBeforeParent = "child-before-parent"
+	// ChildOrder means a resource's children (in ID order) don't have
+	// increasing creation times.  The walker takes the first child's time
+	// as the end of the last owner's interval and the last child's as the
+	// end of the resource.
+	ChildOrder = "child-order"
+	// ManyChildren means a resource has more than two children.
+	ManyChildren = "many-children"
+	// EarlyTransfer means a resource was transacted before it was created.
+	EarlyTransfer = "early-transfer"
+	// LateTransfer means a resource was transacted after it was split or
+	// combined into children, so its final owner interval runs backwards.
+	LateTransfer = "late-transfer"
+)
+
+// Anomaly is a single inconsistency in a simulation's resource graph found
+// by Validate.
+type Anomaly struct {
+	Kind   string
+	ResId  int
+	Time   int
+	Detail string
+}
+
+func (a Anomaly) String() string {
+	return fmt.Sprintf("%v: resid %v at t=%v: %v", a.Kind, a.ResId, a.Time, a.Detail)
+}
+
+// Validate checks the resource graph of simulation simid for cycles,
+// children created before their parents or out of order, resources with
+// more than two children and transactions that occur before a resource is
+// created or after it has been split or combined.  The anomalies found are
+// returned ordered by resource id.
+func Validate(conn *Conn, simid string) ([]Anomaly, error) {
+	as, err := findAnomalies(conn, simid)
+	if err != nil {
+		return nil, fmt.Errorf("simid %v: %w", simid, err)
+	}
+	return as, nil
+}
+
+func findAnomalies(conn *Conn, simid string) (as []Anomaly, err error) {
+	res, err := loadCheckRes(conn, simid)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(res))
+	for id := range res {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	children := map[int][]int{}
+	for _, id := range ids {
+		r := res[id]
+		if r.p1 != 0 {
+			children[r.p1] = append(children[r.p1], id)
+		}
+		if r.p2 != 0 && r.p2 != r.p1 {
+			children[r.p2] = append(children[r.p2], id)
+		}
+	}
+
+	for _, id := range ids {
+		r, kids := res[id], children[id]
+		if len(kids) > 2 {
+			as = append(as, Anomaly{ManyChildren, id, r.time, fmt.Sprintf("%v children %v", len(kids), kids)})
+		}
+		for i, k := range kids {
+			kid := res[k]
+			if kid.time < r.time {
+				as = append(as, Anomaly{ChildBeforeParent, id, r.time, fmt.Sprintf("child %v created at t=%v", k, kid.time)})
+			}
+			if i > 0 && kid.time < res[kids[i-1]].time {
+				as = append(as, Anomaly{ChildOrder, id, r.time, fmt.Sprintf("child %v created at t=%v after child %v at t=%v", k, kid.time, kids[i-1], res[kids[i-1]].time)})
+			}
+		}
+	}
+
+	as = append(as, findCycles(ids, res, children)...)
+
+	rows, err := conn.Query(validTxSql, conn.simId(simid))
+	if err != nil {
+		return nil, fmt.Errorf("loading transactions: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id, t int
+		if err := rows.Scan(&id, &t); err != nil {
+			return nil, fmt.Errorf("loading transactions: %w", err)
+		}
+		r, ok := res[id]
+		if !ok {
+			continue
+		}
+		if t < r.time {
+			as = append(as, Anomaly{EarlyTransfer, id, t, fmt.Sprintf("created at t=%v", r.time)})
+		}
+		if kids := children[id]; len(kids) > 0 && t > res[kids[0]].time {
+			as = append(as, Anomaly{LateTransfer, id, t, fmt.Sprintf("child %v created at t=%v", kids[0], res[kids[0]].time)})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("loading transactions: %w", err)
+	}
+
+	sort.SliceStable(as, func(i, j int) bool { return as[i].ResId < as[j].ResId })
+	return as, nil
+}
+
+// findCycles reports each heritage edge that closes a cycle, found by a
+// depth-first search from every resource.
+func findCycles(ids []int, res map[int]*checkRes, children map[int][]int) (as []Anomaly) {
+	const (
+		unseen = iota
+		onPath
+		done
+	)
+	state := map[int]int{}
+
+	type frame struct {
+		id   int
+		next int
+	}
+	for _, start := range ids {
+		if state[start] != unseen {
+			continue
+		}
+		state[start] = onPath
+		stack := []frame{{id: start}}
+		for len(stack) > 0 {
+			f := &stack[len(stack)-1]
+			kids := children[f.id]
+			if f.next == len(kids) {
+				state[f.id] = done
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			k := kids[f.next]
+			f.next++
+			switch state[k] {
+			case onPath:
+				as = append(as, Anomaly{Cycle, k, res[k].time, fmt.Sprintf("descends from itself via %v", f.id)})
+			case unseen:
+				state[k] = onPath
+				stack = append(stack, frame{id: k})
+			}
+		}
+	}
+	return as
+}
+
+// GetAnomalies returns the anomalies recorded for simulation simid by a
+// walk with validation enabled.
+func GetAnomalies(conn *Conn, simid string) (as []Anomaly, err error) {
+	rows, err := conn.Query(anomalySql, simid)
+	if err != nil {
+		return nil, fmt.Errorf("simid %v: retrieving anomalies: %w", simid, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var a Anomaly
+		if err := rows.Scan(&a.ResId, &a.Time, &a.Kind, &a.Detail); err != nil {
+			return nil, fmt.Errorf("simid %v: retrieving anomalies: %w", simid, err)
+		}
+		as = append(as, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("simid %v: retrieving anomalies: %w", simid, err)
+	}
+	return as, nil
+}
+
+// validate runs Validate for the walker's simulation and records the
+// anomalies found, failing if there are any and the walk is strict.
+// Validation always reads the cyclus tables through the context's
+// connection (WalkAll refuses to validate a custom Source), and anomalies
+// are only recorded if the context's Sink (if any) can write them.
+func (c *Context) validate() error {
+	c.logf(Verbose, "Validating resource graph...")
+	as, err := findAnomalies(c.Conn, c.Simid)
+	if err != nil {
+		return err
+	}
+
+	c.logf(Info, "Found %v resource graph anomalies", len(as))
+	if c.Sink == nil {
+		err = insertAnomalies(c.Conn, c.Simid, as)
+	} else if s, ok := c.Sink.(anomalySink); ok {
+		err = s.WriteAnomalies(c.Simid, as)
+	}
+	if err != nil {
+		return err
+	}
+
+	if c.Strict && len(as) > 0 {
+		return fmt.Errorf("resource graph has %v anomalies (first: %v)", len(as), as[0])
+	}
+	return nil
+}
+
+// insertAnomalies writes as to the InventoryAnomalies table for simid within
+// a single transaction on conn.
+func insertAnomalies(conn *Conn, simid string, as []Anomaly) error {
+	stmt, err := conn.Prepare(anomalyDumpSql)
+	if err != nil {
+		return fmt.Errorf("preparing anomaly insert: %w", err)
+	}
+	defer stmt.Close()
+
+	if err := conn.Exec("BEGIN TRANSACTION;"); err != nil {
+		return fmt.Errorf("dumping anomalies: %w", err)
+	}
+	for _, a := range as {
+		if _, err := stmt.Exec(simid, a.ResId, a.Time, a.Kind, a.Detail); err != nil {
+			conn.Exec("ROLLBACK TRANSACTION;")
+			return fmt.Errorf("resid %v: dumping anomalies: %w", a.ResId, err)
+		}
+	}
+	if err := conn.Exec("END TRANSACTION;"); err != nil {
+		return fmt.Errorf("dumping anomalies: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,615 @@
+// Package inv builds and queries a fast-queryable agent inventory table
+// (Inventories) from the raw resource and transaction tables of a cyclus
+// output database.  Databases are accessed through database/sql, so a driver
+// for them must be registered by importing it.  The cyclus tables may be of
+// the pre-1.0 or 1.x schema (see Schema).  Typical use is:
+//
+//	import _ "github.com/mattn/go-sqlite3"
+//	...
+//	conn, err := inv.Open("sqlite3", "cyclus.sqlite")
+//	...
+//	defer conn.Close()
+//	if err := inv.Prepare(conn); err != nil { ... }
+//	defer inv.Finish(conn)
+//
+//	simids, err := inv.GetSimIds(conn)
+//	...
+//	simids, err = inv.Pending(conn, simids, inv.Build{})
+//	...
+//	if err := inv.Clear(conn, simids...); err != nil { ... }
+//	for _, simid := range simids {
+//	    ctx := inv.NewContext(conn, simid, nil)
+//	    if err := ctx.WalkAll(); err != nil { ... }
+//	}
+package inv
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// The number of sql commands to buffer before dumping to the output database.
+const DumpFreq = 100000
+
+var (
+	preExecStmts = []string{
+		"CREATE TABLE IF NOT EXISTS Inventories (SimID TEXT,ResID INTEGER,AgentID INTEGER,StartTime INTEGER,EndTime INTEGER,Quantity REAL,Units TEXT,Type TEXT,EndReason TEXT);",
+		statusTableSql,
+		nucTableSql,
+		flowsTableSql,
+		anomalyTableSql,
+	}
+	postExecStmts = []string{
+		Index("Inventories", "SimID", "AgentID"),
+		Index("Inventories", "SimID", "StartTime"),
+		Index("Inventories", "SimID", "EndTime"),
+		Index("InventoryNuclides", "SimID", "AgentID"),
+		Index("InventoryNuclides", "SimID", "NucID"),
+		Index("Flows", "SimID", "Time"),
+	}
+	// invCols are the columns of the Inventories table that were added after
+	// its original five; Prepare adds them to tables built by older versions.
+	invCols = []string{"Quantity REAL", "Units TEXT", "Type TEXT", "EndReason TEXT"}
+
+	dumpSql    = "INSERT INTO Inventories (SimID,ResID,AgentID,StartTime,EndTime,Quantity,Units,Type,EndReason) VALUES (?,?,?,?,?,?,?,?,?);"
+	resSqlHead = "SELECT ID,TimeCreated%v FROM "
+	resSqlTail = " WHERE Parent1 = ? OR Parent2 = ?;"
+
+	ownerSql = `SELECT tr.ReceiverID, tr.Time, tr.{Transactions.ID}, tr.SenderID, tr.Commodity FROM Transactions AS tr
+				  INNER JOIN {TransactedResources} AS trr ON tr.{Transactions.ID} = trr.TransactionID
+				  WHERE trr.ResourceID = ? AND tr.SimID = ? AND trr.SimID = ?
+				  ORDER BY tr.Time ASC;`
+	rootsSql = `SELECT res.{Resources.ID},res.TimeCreated,rc.{ResCreators.ModelID}%v FROM Resources AS res
+				  INNER JOIN ResCreators AS rc ON res.{Resources.ID} = rc.{ResCreators.ResID}
+				  WHERE res.SimID = ? AND rc.SimID = ?;`
+
+	deathsSql = "SELECT AgentID,{AgentDeaths.DeathDate} FROM {AgentDeaths} WHERE SimID = ?;"
+
+	// qtyCols are the extra resource columns retrieved when walking with
+	// quantities; they are substituted into the resource queries above.
+	qtyCols = ",Quantity,units,Type"
+)
+
+// Prepare creates necessary indexes and tables required for efficient
+// calculation of cyclus simulation inventory information.  Should be called
+// once before walking begins.  Existing inventory rows are left in place; use
+// Clear to remove those of simulations that are about to be rebuilt.  The
+// indexes on the cyclus tables are kept; use PrepareWith to control them.
+func Prepare(conn *Conn) error {
+	return PrepareWith(conn, IndexOptions{})
+}
+
+// PrepareWith is like Prepare but indexes the cyclus tables as directed by
+// opts.
+//
+// If the cyclus tables are read from a database attached with AttachInput,
+// it is left untouched: no indexes are created on its tables.
+func PrepareWith(conn *Conn, opts IndexOptions) error {
+	Log.Logf(Verbose, "Reading cyclus %v tables...", conn.Schema)
+	Log.Logf(Info, "Creating inventory tables...")
+	for _, stmt := range preExecStmts {
+		if err := conn.Exec(conn.ddl(stmt)); err != nil {
+			return fmt.Errorf("creating inventory tables: %w", err)
+		}
+	}
+	if err := addColumns(conn, "Inventories", invCols); err != nil {
+		return err
+	}
+	if err := addColumns(conn, "InventoryStatus", statusCols); err != nil {
+		return err
+	}
+	return createIndexes(conn, opts)
+}
+
+// addColumns adds each of cols (given as "Name TYPE") to table if it doesn't
+// already have a column of that name.
+func addColumns(conn *Conn, table string, cols []string) error {
+	names, err := columns(conn, table)
+	if err != nil {
+		return err
+	}
+	have := map[string]bool{}
+	for _, name := range names {
+		have[name] = true
+	}
+
+	for _, col := range cols {
+		name := strings.Fields(col)[0]
+		if have[strings.ToLower(name)] {
+			continue
+		}
+		if err := conn.Exec(conn.ddl("ALTER TABLE " + table + " ADD COLUMN " + col + ";")); err != nil {
+			return fmt.Errorf("adding %v column %v: %w", table, name, err)
+		}
+	}
+	return nil
+}
+
+// Clear removes all existing inventory rows for the given simulation ids
+// from conn's Inventories table, leaving those of other simulations intact.
+// The simulations are marked incomplete in the same transaction so an
+// interrupted rebuild is picked up again by Pending.
+func Clear(conn *Conn, simids ...string) error {
+	if err := conn.Exec("BEGIN TRANSACTION;"); err != nil {
+		return err
+	}
+	for _, simid := range simids {
+		err := conn.Exec("DELETE FROM Inventories WHERE SimID = ?;", simid)
+		if err == nil {
+			err = conn.Exec("DELETE FROM InventoryNuclides WHERE SimID = ?;", simid)
+		}
+		if err == nil {
+			err = conn.Exec("DELETE FROM Flows WHERE SimID = ?;", simid)
+		}
+		if err == nil {
+			err = conn.Exec("DELETE FROM InventoryAnomalies WHERE SimID = ?;", simid)
+		}
+		if err == nil {
+			err = conn.Exec("DELETE FROM InventoryStatus WHERE SimID = ?;", simid)
+		}
+		if err == nil {
+			err = conn.Exec("INSERT INTO InventoryStatus (SimID,Version) VALUES (?,?);", simid, Version)
+		}
+		if err != nil {
+			conn.Exec("ROLLBACK TRANSACTION;")
+			return fmt.Errorf("simid %v: clearing inventories: %w", simid, err)
+		}
+	}
+	return conn.Exec("END TRANSACTION;")
+}
+
+// Finish should be called for a cyclus database after all walkers have
+// completed processing inventory data. It creates final indexes and other
+// finishing tasks.
+//
+// Any temporary indexes created by PrepareWith are dropped.
+func Finish(conn *Conn) (err error) {
+	if err := dropTmpIndexes(conn); err != nil {
+		return err
+	}
+
+	Log.Logf(Info, "Creating inventory indexes...")
+	for _, stmt := range postExecStmts {
+		if err := conn.Exec(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Reasons an inventory interval ends, recorded in the EndReason column of
+// the Inventories table.
+const (
+	// EndSplit means the resource was split or combined into new resources.
+	EndSplit = "split"
+	// EndTransfer means the resource was transferred to another agent.
+	EndTransfer = "transferred"
+	// EndDied means the owning agent was decommissioned.
+	EndDied = "died"
+	// EndSimEnd means the resource was still held when the simulation ended.
+	EndSimEnd = "simulation end"
+)
+
+// Node represents a single inventory interval: the resource ResId owned by
+// agent OwnerId from StartTime up to (but not including) EndTime.  EndReason
+// records why the interval ended.  Quantity, Units and Type describe the
+// resource and are only set when walking with quantities.
+type Node struct {
+	ResId     int
+	OwnerId   int
+	StartTime int
+	EndTime   int
+	EndReason string
+	Quantity  float64
+	Units     string
+	Type      string
+}
+
+// Context encapsulates the logic for building a fast, queryable inventories
+// table for a specific simulation from raw cyclus output database.
+type Context struct {
+	*Conn
+	// Simid is the cyclus simulation id targeted by this context.  Must be
+	// set.
+	Simid       string
+	mappednodes map[int32]struct{}
+	resCount    int
+	nodes       []*Node
+	duration    int
+	deaths      map[int]int
+	// OnEvent, if non-nil, is called with each step of the walk and each
+	// inventory interval written.
+	OnEvent func(e Event)
+	// InMemory causes the simulation's resource heritage and ownership
+	// changes to be bulk-loaded into memory up front rather than queried
+	// from the database for every resource walked.  This is much faster for
+	// large simulations at the cost of memory proportional to their size.
+	InMemory bool
+	// Quantities causes each resource's quantity, units and type to be
+	// retrieved while walking and written to the Inventories table.
+	// Otherwise those columns are left null.
+	Quantities bool
+	// Flows causes the quantity transferred by each transaction to be
+	// accumulated while walking and written to the Flows table.
+	Flows bool
+	flows map[int]*Flow
+	// Validate causes the simulation's resource graph to be checked for
+	// anomalies the walker can't handle (see Validate) before walking.  Any
+	// found are written to the InventoryAnomalies table.  Validation reads
+	// the cyclus tables, so it can't be combined with a custom Source.
+	Validate bool
+	// Strict causes WalkAll to fail before writing any inventories if
+	// validation finds anomalies.
+	Strict bool
+	// Log, if non-nil, receives the context's progress messages instead of
+	// the package Log.
+	Log Logger
+	// Source, if non-nil, provides the simulation's resource graph instead
+	// of it being read from the cyclus tables through the context's
+	// connection.  InMemory has no effect on a custom Source.
+	Source Source
+	// Sink, if non-nil, receives all inventory intervals and flows instead
+	// of them being inserted directly through the context's own connection.
+	// A Writer used as the Sink allows several contexts to walk concurrently
+	// on separate read connections.
+	Sink   Sink
+	src    Source
+	sqlSrc *sqlSource
+	sink   Sink
+	dbSink *dbSink
+}
+
+// NewContext creates a walker for the simulation simid in the database conn.
+// If onEvent is non-nil, it is set as the context's OnEvent callback.
+func NewContext(conn *Conn, simid string, onEvent func(e Event)) *Context {
+	return &Context{
+		Conn:    conn,
+		Simid:   simid,
+		OnEvent: onEvent,
+	}
+}
+
+// log returns the Logger for the context's progress messages.
+func (c *Context) log() Logger {
+	if c.Log != nil {
+		return c.Log
+	}
+	return Log
+}
+
+func (c *Context) logf(level Level, format string, args ...interface{}) {
+	c.log().Logf(level, format, args...)
+}
+
+func (c *Context) init() (err error) {
+	c.nodes = make([]*Node, 0, 10000)
+	c.mappednodes = map[int32]struct{}{}
+	c.flows = map[int]*Flow{}
+
+	c.src, c.sink = c.Source, c.Sink
+	if c.src == nil {
+		if c.sqlSrc, err = openSQLSource(c.Conn, c.Simid, c.InMemory, c.needQty(), c.log()); err != nil {
+			return err
+		}
+		c.src = c.sqlSrc
+	}
+	if c.sink == nil {
+		if c.dbSink, err = newDBSink(c.Conn); err != nil {
+			c.close()
+			return err
+		}
+		c.sink = c.dbSink
+	}
+
+	if err := c.initLifetimes(); err != nil {
+		c.close()
+		return err
+	}
+	return nil
+}
+
+// initLifetimes loads the simulation duration and agent decommissioning
+// times used to end the intervals of resources held until then.
+func (c *Context) initLifetimes() (err error) {
+	if c.duration, err = c.src.Duration(); err != nil {
+		return err
+	}
+	c.deaths, err = c.src.Deaths()
+	return err
+}
+
+// agentDeaths returns the decommissioning time of each agent in simid that
+// was decommissioned.  Databases without an AgentDeaths table are treated as
+// having no decommissionings.
+func agentDeaths(conn *Conn, simid string) (deaths map[int]int, err error) {
+	deaths = map[int]int{}
+	if ok, err := hasTable(conn, conn.Schema.Name("AgentDeaths")); err != nil || !ok {
+		return deaths, err
+	}
+
+	rows, err := conn.Query(deathsSql, conn.simId(simid))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving agent deaths: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var agent, t int
+		if err := rows.Scan(&agent, &t); err != nil {
+			return nil, fmt.Errorf("retrieving agent deaths: %w", err)
+		}
+		deaths[agent] = t
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("retrieving agent deaths: %w", err)
+	}
+	return deaths, nil
+}
+
+// needQty reports whether resource quantities must be retrieved while
+// walking, either for writing or for computing flows.
+func (c *Context) needQty() bool {
+	return c.Quantities || c.Flows
+}
+
+// closeSource releases the database Source if the context opened one.
+func (c *Context) closeSource() error {
+	if c.sqlSrc == nil {
+		return nil
+	}
+	err := c.sqlSrc.close()
+	c.sqlSrc = nil
+	return err
+}
+
+// close releases the database Source and Sink if the context opened them.
+func (c *Context) close() error {
+	err := c.closeSource()
+	if c.dbSink != nil {
+		if err2 := c.dbSink.close(); err == nil {
+			err = err2
+		}
+		c.dbSink = nil
+	}
+	return err
+}
+
+// WalkAll constructs the inventories table in the cyclus database alongside
+// other tables. Creates several indexes in the process.  Finish should be
+// called on the database connection after all simulation id's have been
+// walked.  Any returned error identifies the simulation (and resource where
+// applicable) being processed; the connection remains usable for walking
+// other simulations.
+func (c *Context) WalkAll() (err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("simid %v: %w", c.Simid, err)
+		}
+	}()
+
+	if c.Validate && c.Source != nil {
+		return errors.New("validation is not supported with a custom Source")
+	}
+
+	c.logf(Info, "--- Building inventories for simid %v ---", c.Simid)
+	if c.Validate {
+		if err := c.validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := c.init(); err != nil {
+		return err
+	}
+	defer c.close()
+
+	c.logf(Verbose, "Retrieving root resource nodes...")
+	roots, err := c.src.Roots()
+	if err != nil {
+		return err
+	}
+
+	c.logf(Info, "Found %v root nodes", len(roots))
+	for i, n := range roots {
+		c.logf(Verbose, "    Processing root %d...", i)
+		c.emit(Event{Kind: RootStart, Time: n.StartTime, Node: *n})
+		if err := c.walkDown(n); err != nil {
+			return err
+		}
+		c.log().Progress(Progress{Simid: c.Simid, RootsDone: i + 1, Roots: len(roots), Resources: c.resCount})
+	}
+
+	if err := c.closeSource(); err != nil {
+		return err
+	}
+
+	if err := c.dumpNodes(); err != nil {
+		return err
+	}
+	if err := c.dumpFlows(); err != nil {
+		return err
+	}
+
+	return c.sink.Complete(c.Simid, Build{Quantities: c.Quantities, Flows: c.Flows})
+}
+
+// walkDown walks the resource heritage graph below root depth-first,
+// recording ownership intervals for every resource it reaches.  An explicit
+// stack is used rather than recursion because heritage chains in long
+// simulations can be hundreds of thousands of resources deep.
+func (c *Context) walkDown(root *Node) error {
+	stack := []*Node{root}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		kids, err := c.visit(node)
+		if err != nil {
+			return err
+		}
+
+		// push in reverse so children are visited in query order, matching
+		// a recursive pre-order walk.
+		for i := len(kids) - 1; i >= 0; i-- {
+			stack = append(stack, kids[i])
+		}
+	}
+	return nil
+}
+
+// visit records the inventory intervals for node and returns its children
+// (with owners assigned) that still need to be walked.
+func (c *Context) visit(node *Node) (kids []*Node, err error) {
+	if _, ok := c.mappednodes[int32(node.ResId)]; ok {
+		return nil, nil
+	}
+	c.mappednodes[int32(node.ResId)] = struct{}{}
+
+	// dump if necessary
+	c.resCount++
+	if c.resCount%DumpFreq == 0 {
+		if err := c.dumpNodes(); err != nil {
+			return nil, err
+		}
+	}
+
+	// find resource's children
+	if kids, err = c.src.Kids(node.ResId); err != nil {
+		return nil, err
+	}
+	if len(kids) > 0 {
+		node.EndTime, node.EndReason = kids[len(kids)-1].StartTime, EndSplit
+	}
+
+	// find resources owner changes (that occurred before children)
+	owners, times, err := c.getNewOwners(node)
+	if err != nil {
+		return nil, err
+	}
+
+	childOwner := node.OwnerId
+	if len(owners) > 0 {
+		lastend, lastreason := math.MaxInt32, ""
+		if len(kids) > 0 {
+			lastend, lastreason = kids[0].StartTime, EndSplit
+		}
+		node.EndTime, node.EndReason = times[0], EndTransfer
+		childOwner = owners[len(owners)-1]
+
+		times = append(times, lastend)
+		for i := range owners {
+			n := *node
+			n.OwnerId, n.StartTime, n.EndTime = owners[i], times[i], times[i+1]
+			if i == len(owners)-1 {
+				n.EndReason = lastreason
+			}
+			c.record(&n)
+
+			from := node.OwnerId
+			if i > 0 {
+				from = owners[i-1]
+			}
+			c.emit(Event{Kind: Transfer, Time: times[i], Node: n, From: from})
+		}
+	}
+
+	c.record(node)
+
+	if len(kids) > 0 && c.OnEvent != nil {
+		ids := make([]int, len(kids))
+		for i, k := range kids {
+			ids[i] = k.ResId
+		}
+		c.emit(Event{Kind: Split, Time: kids[0].StartTime, Node: *node, Kids: ids})
+	}
+
+	for _, child := range kids {
+		child.OwnerId = childOwner
+	}
+	return kids, nil
+}
+
+// record caps n's interval and queues it for the sink.  Intervals that would
+// begin after their owner was decommissioned, because the resource was sent
+// to or split under an agent that had already exited, are left out.
+func (c *Context) record(n *Node) {
+	c.capEnd(n)
+	if n.EndReason == EndDied && n.EndTime == n.StartTime {
+		return
+	}
+	c.nodes = append(c.nodes, n)
+}
+
+// capEnd ends n's interval when its owner is decommissioned or the
+// simulation ends, if either happens before it would otherwise end.
+func (c *Context) capEnd(n *Node) {
+	if death, ok := c.deaths[n.OwnerId]; ok && death < n.EndTime && death < c.duration {
+		n.EndTime, n.EndReason = max(death, n.StartTime), EndDied
+	} else if c.duration < n.EndTime {
+		n.EndTime, n.EndReason = c.duration, EndSimEnd
+	}
+}
+
+// getNewOwners returns the agents that node's resource was transferred to
+// and the times of those transfers, recording the transfers as flows if
+// enabled.  Transfers of the resource to the agent already holding it don't
+// change its owner and are left out.
+func (c *Context) getNewOwners(node *Node) (owners, times []int, err error) {
+	changes, err := c.src.Owners(node.ResId)
+	if err != nil {
+		return nil, nil, err
+	}
+	owner := node.OwnerId
+	for _, o := range changes {
+		if c.Flows {
+			c.addFlow(o.Tx, o.Sender, o.Receiver, o.Commodity, o.Time, node.Quantity)
+		}
+		if o.Receiver == owner {
+			continue
+		}
+		owner = o.Receiver
+		owners = append(owners, o.Receiver)
+		times = append(times, o.Time)
+	}
+	return owners, times, nil
+}
+
+func (c *Context) dumpNodes() error {
+	c.logf(Verbose, "    Dumping inventories (%d resources done)...", c.resCount)
+	if err := c.sink.Write(c.Simid, c.nodes, c.Quantities); err != nil {
+		return err
+	}
+
+	for _, n := range c.nodes {
+		c.emit(Event{Kind: Interval, Time: n.StartTime, Node: *n})
+	}
+
+	c.nodes = c.nodes[:0]
+	return nil
+}
+
+// insertNodes writes nodes as inventory rows for simid using the prepared
+// insert stmt within a single transaction on conn.  The quantity columns are
+// left null unless qty is true.
+func insertNodes(conn *Conn, stmt *sql.Stmt, simid string, nodes []*Node, qty bool) error {
+	if err := conn.Exec("BEGIN TRANSACTION;"); err != nil {
+		return fmt.Errorf("dumping inventories: %w", err)
+	}
+
+	for _, n := range nodes {
+		var q, units, typ interface{}
+		if qty {
+			q, units, typ = n.Quantity, n.Units, n.Type
+		}
+		if _, err := stmt.Exec(simid, n.ResId, n.OwnerId, n.StartTime, n.EndTime, q, units, typ, n.EndReason); err != nil {
+			conn.Exec("ROLLBACK TRANSACTION;")
+			return fmt.Errorf("resid %v: dumping inventories: %w", n.ResId, err)
+		}
+	}
+	if err := conn.Exec("END TRANSACTION;"); err != nil {
+		return fmt.Errorf("dumping inventories: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/rwcarlsen/source-sink/inventory/inv"
+)
+
+func doLineage(args []string) {
+	fs := flag.NewFlagSet("lineage", flag.ExitOnError)
+	simid := fs.String("simid", "", "Simulation id (may be omitted if the db holds only one).")
+	in := fs.String("in", "", "Cyclus sqlite db the inventory db was built from with -out, read for the cyclus tables.")
+	resid := fs.Int("res", -1, "Id of the resource to trace.")
+	format := fs.String("format", "text", "Output format (text or json).")
+	fs.Usage = func() {
+		fmt.Println("Usage: inventory lineage [-simid id] [-in cyclus-db] -res id [db]")
+		fmt.Println("Prints the ancestry, descendants and owner timelines of a resource.")
+		fmt.Println()
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 1 || *resid < 0 {
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	conn, err := openWithInput(fs.Arg(0), *in)
+	fatalif(err)
+	defer conn.Close()
+
+	id, err := pickSimId(conn, *simid)
+	fatalif(err)
+
+	lin, err := inv.GetLineage(conn, id, *resid)
+	fatalif(err)
+
+	switch *format {
+	case "text":
+		writeProvenance(os.Stdout, lin.Res)
+		fmt.Println("Ancestors:")
+		for _, p := range lin.Ancestors {
+			writeProvenance(os.Stdout, p)
+		}
+		fmt.Println("Descendants:")
+		for _, p := range lin.Descendants {
+			writeProvenance(os.Stdout, p)
+		}
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "    ")
+		fatalif(enc.Encode(lin))
+	default:
+		fatalif(fmt.Errorf("unknown output format %q", *format))
+	}
+}
+
+func writeProvenance(w io.Writer, p *inv.Provenance) {
+	fmt.Fprintf(w, "    Res %v: t=%v, qty %v", p.ResId, p.TimeCreated, p.Quantity)
+	if p.Root {
+		fmt.Fprintf(w, ", created by agent %v", p.Creator)
+	} else {
+		fmt.Fprintf(w, ", parents %v %v", p.Parent1, p.Parent2)
+	}
+	fmt.Fprintln(w)
+	for _, n := range p.Owners {
+		fmt.Fprintf(w, "        agent %v from t=%v to %v", n.OwnerId, n.StartTime, n.EndTime)
+		if n.EndReason != "" {
+			fmt.Fprintf(w, " (%v)", n.EndReason)
+		}
+		fmt.Fprintln(w)
+	}
+}
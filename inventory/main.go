@@ -2,41 +2,267 @@
 package main
 
 import (
-	"fmt"
+	"errors"
 	"flag"
+	"fmt"
 	"log"
+	"os"
+	"strings"
+
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/rwcarlsen/source-sink/inventory/inv"
+)
 
-	"code.google.com/p/go-sqlite/go1/sqlite3"
+var (
+	help   = flag.Bool("h", false, "Print this help message.")
+	njobs  = flag.Int("j", 1, "Number of simulations to build inventories for concurrently.")
+	inmem  = flag.Bool("mem", false, "Load each simulation's resource graph into memory instead of querying per resource (always done for hdf5 files).")
+	nuc    = flag.Bool("nuc", false, "Also build the per-nuclide InventoryNuclides table from resource compositions.")
+	flows  = flag.Bool("flows", false, "Also build the Flows table of material transferred by each transaction.")
+	qty    = flag.Bool("qty", false, "Record each resource's quantity, units and type in its inventory rows.")
+	valid  = flag.Bool("validate", false, "Check each simulation's resource graph for anomalies and record them in the InventoryAnomalies table.")
+	strict = flag.Bool("strict", false, "Fail rather than build inventories for simulations whose resource graph has anomalies (implies -validate).")
+	force  = flag.Bool("force", false, "Rebuild inventories even for simulations that are already complete.")
+	out    = flag.String("out", "", "Write inventories to this sqlite file instead, leaving the cyclus db untouched (best combined with -mem).  Commands read it given the cyclus db with -in.")
+	index  = flag.String("index", "keep", "How to index the cyclus tables: keep (leave indexes in place), temp (drop them when done) or none.")
+	icost  = flag.Bool("indexcost", false, "Report the disk space used by each cyclus table index created.")
+	quiet  = flag.Bool("q", false, "Don't print progress messages.")
+	verb   = flag.Bool("v", false, "Print detailed progress messages.")
+	simid  = flag.String("simid", "", "Comma separated simulation ids (or id prefixes) to build inventories for (default all).")
+	driver = flag.String("driver", "", "database/sql driver for the cyclus db (default postgres for postgres:// urls, sqlite3 otherwise).")
 )
 
-var help = flag.Bool("h", false, "Print this help message.")
+// cmds holds the subcommands of inventory.  Each is passed the command line
+// arguments following its name.
+var cmds = map[string]func(args []string){
+	"series":   doSeries,
+	"nuclides": doNuclides,
+	"dot":      doDot,
+	"lineage":  doLineage,
+	"check":    doCheck,
+	"export":   doExport,
+}
 
 func main() {
 	log.SetFlags(0)
 	flag.Parse()
 
+	if flag.NArg() > 0 {
+		if cmd, ok := cmds[flag.Arg(0)]; ok {
+			cmd(flag.Args()[1:])
+			return
+		}
+	}
+
 	if *help || flag.NArg() != 1 {
 		fmt.Println("Usage: inventory [cyclus-db]")
-		fmt.Println("Creates a fast queryable inventory table for a cyclus sqlite output file.\n")
+		fmt.Println("       inventory [-driver name] <command> [args] [cyclus-db]")
+		fmt.Println("Creates a fast queryable inventory table for a cyclus output database, either")
+		fmt.Println("a sqlite file or a postgres:// url of a PostgreSQL copy.  Cyclus hdf5 output")
+		fmt.Println("is read only when built with -tags hdf5 (needs the HDF5 C library), and its")
+		fmt.Println("inventories are written to the sqlite db named with -out.")
+		fmt.Println()
+		fmt.Println("Commands (run with -h for details):")
+		fmt.Println("    series    per-timestep inventory of an agent or prototype")
+		fmt.Println("    nuclides  nuclide masses held by an agent or prototype at a given time")
+		fmt.Println("    dot       graphviz heritage graph of resources passing through an agent")
+		fmt.Println("    lineage   ancestry, descendants and owners of a resource")
+		fmt.Println("    check     verify conservation of mass across resources and inventories")
+		fmt.Println("    export    stream inventory intervals as csv or json lines")
+		fmt.Println()
 		flag.PrintDefaults()
 		return
 	}
 
-
 	fname := flag.Arg(0)
 
-	conn, err := sqlite3.Open(fname)
+	// progress goes to stderr so it can't be confused with any output
+	level := inv.Info
+	if *quiet {
+		level = inv.Quiet
+	} else if *verb {
+		level = inv.Verbose
+	}
+	inv.Log = inv.NewLogger(os.Stderr, level)
+
+	if isHDF5(fname) {
+		walkHDF5(fname)
+		return
+	}
+
+	// open connects to the cyclus db for reading.  With a separate output
+	// db, it is only ever attached read-only.
+	open := func() (*inv.Conn, error) { return openDb(fname) }
+	if *out != "" {
+		open = func() (*inv.Conn, error) {
+			conn, err := inv.Open(sqliteDriver, ":memory:")
+			if err != nil {
+				return nil, err
+			}
+			if err := inv.AttachInput(conn, fname); err != nil {
+				conn.Close()
+				return nil, err
+			}
+			return conn, nil
+		}
+	}
+
+	var conn *inv.Conn
+	var err error
+	if *out != "" {
+		conn, err = inv.Open(sqliteDriver, *out)
+		if err == nil {
+			err = inv.AttachInput(conn, fname)
+		}
+	} else {
+		conn, err = open()
+	}
 	fatalif(err)
-	defer conn.Close()
 
-	fatalif(Prepare(conn))
-	defer Finish(conn)
+	opts := inv.IndexOptions{Report: *icost}
+	switch *index {
+	case "keep":
+	case "temp":
+		opts.Temporary = true
+	case "none":
+		opts.Skip = true
+	default:
+		log.Fatalf("unknown index mode %q", *index)
+	}
+	fatalif(inv.PrepareWith(conn, opts))
+	finish(conn, build(conn, open))
+}
 
-	simids, err := GetSimIds(conn)
+// build builds inventories in conn for the simulations chosen on the command
+// line.  With -j, the simulations are walked concurrently on read
+// connections made by open.  A failure for one simulation doesn't stop the
+// others; all failures are returned together.
+func build(conn *inv.Conn, open func() (*inv.Conn, error)) error {
+	simids, err := inv.GetSimIds(conn)
+	if err != nil {
+		return err
+	}
+	simids, err = selectSimIds(conn, simids)
+	if err != nil || len(simids) == 0 {
+		return err
+	}
+
+	var errs []error
+	if *njobs <= 1 {
+		for _, simid := range simids {
+			ctx := inv.NewContext(conn, simid, nil)
+			configure(ctx)
+			errs = append(errs, ctx.WalkAll())
+		}
+	} else {
+		w, err := inv.NewWriter(conn)
+		if err != nil {
+			return err
+		}
+		err = inv.WalkAllParallel(open, w, simids, *njobs, configure)
+		if cerr := w.Close(); cerr != nil {
+			return cerr
+		}
+		errs = append(errs, err)
+	}
+
+	if *nuc {
+		// simulations whose walk failed are still pending and get no
+		// nuclide inventories
+		failed, err := inv.Pending(conn, simids, inv.Build{Quantities: *qty, Flows: *flows})
+		if err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+		skip := map[string]bool{}
+		for _, simid := range failed {
+			skip[simid] = true
+		}
+		for _, simid := range simids {
+			if !skip[simid] {
+				errs = append(errs, inv.BuildNuclides(conn, simid))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// finish completes the inventory database for conn after inventories have
+// been built (or failed to be) with error err, then closes conn.  Any
+// temporary cyclus table indexes are dropped even after a failure.  It
+// exits on err or an error finishing.
+func finish(conn *inv.Conn, err error) {
+	if ferr := inv.Finish(conn); err == nil {
+		err = ferr
+	}
+	conn.Close()
 	fatalif(err)
+}
+
+// selectSimIds returns those of simids chosen with -simid that need
+// inventories built with the requested parts (all of them with -force),
+// clearing any inventories they already have in conn.
+func selectSimIds(conn *inv.Conn, simids []string) ([]string, error) {
+	var err error
+	if *simid != "" {
+		if simids, err = inv.FilterSimIds(simids, strings.Split(*simid, ",")...); err != nil {
+			return nil, err
+		}
+	}
+	if !*force {
+		if simids, err = inv.Pending(conn, simids, inv.Build{Quantities: *qty, Flows: *flows, Nuclides: *nuc}); err != nil {
+			return nil, err
+		}
+	}
+	if len(simids) == 0 {
+		inv.Log.Logf(inv.Info, "Inventories are up to date.")
+		return nil, nil
+	}
+	return simids, inv.Clear(conn, simids...)
+}
+
+// sqliteDriver is the database/sql driver used for sqlite databases.
+const sqliteDriver = "sqlite3"
+
+// openDb opens the database named by dsn with the driver given by the
+// -driver flag, guessing it from dsn if unset.
+func openDb(dsn string) (*inv.Conn, error) {
+	name := *driver
+	if name == "" {
+		name = sqliteDriver
+		if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+			name = "postgres"
+		}
+	}
+	return inv.Open(name, dsn)
+}
+
+// openWithInput opens the database named by dsn for a subcommand.  If in is
+// given, dsn holds inventories built with -out and the cyclus sqlite
+// database in they were built from is attached for reading.
+func openWithInput(dsn, in string) (*inv.Conn, error) {
+	conn, err := openDb(dsn)
+	if err != nil || in == "" {
+		return conn, err
+	}
+	if err := inv.AttachInput(conn, in); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// configure applies command line options to a newly created walker.
+func configure(ctx *inv.Context) {
+	ctx.InMemory = *inmem
+	ctx.Quantities = *qty
+	ctx.Flows = *flows
+	ctx.Validate = *valid || *strict
+	ctx.Strict = *strict
+}
 
-	for _, simid := range simids {
-		ctx := NewContext(conn, simid, nil)
-		fatalif(ctx.WalkAll())
+func fatalif(err error) {
+	if err != nil {
+		log.Fatal(err)
 	}
 }
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"database/sql"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// argsEnv is set to the newline separated arguments when the test binary is
+// run as the inventory command by runInventory.
+const argsEnv = "INVENTORY_TEST_ARGS"
+
+func TestMain(m *testing.M) {
+	if args, ok := os.LookupEnv(argsEnv); ok {
+		os.Args = append([]string{"inventory"}, strings.Split(args, "\n")...)
+		main()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// runInventory runs the inventory command with args and returns what it
+// printed to stdout.
+func runInventory(t *testing.T, args ...string) string {
+	cmd := exec.Command(os.Args[0])
+	cmd.Env = append(os.Environ(), argsEnv+"="+strings.Join(args, "\n"))
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("inventory %v: %v\n%s", strings.Join(args, " "), err, stderr.String())
+	}
+	return string(out)
+}
+
+// cyclusSql is a single simulation in which agent 1 creates resource 1 at
+// t=0, splits it at t=1 and sends half of it to agent 2 at t=2.
+var cyclusSql = []string{
+	`CREATE TABLE SimulationTimeInfo (SimID TEXT, SimHandle TEXT, InitialYear INTEGER, InitialMonth INTEGER, SimulationStart INTEGER, Duration INTEGER);`,
+	`INSERT INTO SimulationTimeInfo VALUES('sim1','',2010,1,0,4);`,
+	`CREATE TABLE Agents (SimID TEXT, ID INTEGER, AgentType TEXT, ModelType TEXT, Prototype TEXT, ParentID INTEGER, EnterDate INTEGER);`,
+	`INSERT INTO Agents VALUES('sim1',1,'Facility','Source','source',0,0);`,
+	`INSERT INTO Agents VALUES('sim1',2,'Facility','Sink','sink',0,0);`,
+	`CREATE TABLE Resources (SimID TEXT, ID INTEGER, Type TEXT, TimeCreated INTEGER, Quantity REAL, units TEXT, StateId INTEGER, Parent1 INTEGER, Parent2 INTEGER);`,
+	`INSERT INTO Resources VALUES('sim1',1,'GenericResource',0,10.0,'kg',0,0,0);`,
+	`INSERT INTO Resources VALUES('sim1',2,'GenericResource',1,5.0,'kg',0,1,0);`,
+	`INSERT INTO Resources VALUES('sim1',3,'GenericResource',1,5.0,'kg',0,1,0);`,
+	`CREATE TABLE ResCreators (SimID TEXT, ResID INTEGER, ModelID INTEGER);`,
+	`INSERT INTO ResCreators VALUES('sim1',1,1);`,
+	`CREATE TABLE Transactions (SimID TEXT, ID INTEGER, SenderID INTEGER, ReceiverID INTEGER, MarketID INTEGER, Commodity TEXT, Price REAL, Time INTEGER);`,
+	`INSERT INTO Transactions VALUES('sim1',1,1,2,0,'milk',0,2);`,
+	`CREATE TABLE TransactedResources (SimID TEXT, TransactionID INTEGER, Position INTEGER, ResourceID INTEGER);`,
+	`INSERT INTO TransactedResources VALUES('sim1',1,0,3);`,
+	`CREATE TABLE AgentDeaths (SimID TEXT, AgentID INTEGER, DeathDate INTEGER);`,
+}
+
+func TestOutputDb(t *testing.T) {
+	dir := t.TempDir()
+	cyclus, out := filepath.Join(dir, "cyclus.sqlite"), filepath.Join(dir, "inv.sqlite")
+
+	db, err := sql.Open(sqliteDriver, cyclus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stmt := range cyclusSql {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db.Close()
+
+	runInventory(t, "-q", "-mem", "-out", out, cyclus)
+
+	got := runInventory(t, "series", "-in", cyclus, "-proto", "sink", out)
+	want := "Time,Quantity\n0,0\n1,0\n2,5\n3,5\n"
+	if got != want {
+		t.Errorf("series: expected:\n%s\ngot:\n%s", want, got)
+	}
+
+	got = runInventory(t, "export", "-in", cyclus, out)
+	want = `SimID,ResID,AgentID,StartTime,EndTime,EndReason
+sim1,1,1,0,1,split
+sim1,3,1,1,2,transferred
+sim1,2,1,1,4,simulation end
+sim1,3,2,2,4,simulation end
+`
+	if got != want {
+		t.Errorf("export: expected:\n%s\ngot:\n%s", want, got)
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/rwcarlsen/source-sink/inventory/inv"
+)
+
+func doNuclides(args []string) {
+	fs := flag.NewFlagSet("nuclides", flag.ExitOnError)
+	simid := fs.String("simid", "", "Simulation id (may be omitted if the db holds only one).")
+	in := fs.String("in", "", "Cyclus sqlite db the inventory db was built from with -out, read for the cyclus tables.")
+	agent := fs.Int("agent", -1, "Id of the agent to report the inventory of.")
+	proto := fs.String("proto", "", "Report the combined inventory of all agents of this prototype.")
+	t := fs.Int("t", 0, "Timestep to report the inventory at.")
+	format := fs.String("format", "csv", "Output format (csv or json).")
+	fs.Usage = func() {
+		fmt.Println("Usage: inventory nuclides [-simid id] [-in cyclus-db] -agent id|-proto name -t time [db]")
+		fmt.Println("Prints the mass of each nuclide held at a timestep.")
+		fmt.Println()
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 1 || (*agent < 0) == (*proto == "") {
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	conn, err := openWithInput(fs.Arg(0), *in)
+	fatalif(err)
+	defer conn.Close()
+
+	id, err := pickSimId(conn, *simid)
+	fatalif(err)
+	agents, err := pickAgents(conn, id, *agent, *proto)
+	fatalif(err)
+
+	masses, err := inv.Nuclides(conn, id, *t, agents...)
+	fatalif(err)
+	fatalif(writeNuclides(os.Stdout, *format, masses))
+}
+
+type nucMass struct {
+	NucID int
+	Mass  float64
+}
+
+func writeNuclides(w io.Writer, format string, masses map[int]float64) error {
+	nucs := make([]nucMass, 0, len(masses))
+	for nuc, m := range masses {
+		nucs = append(nucs, nucMass{nuc, m})
+	}
+	sort.Slice(nucs, func(i, j int) bool { return nucs[i].NucID < nucs[j].NucID })
+
+	switch format {
+	case "csv":
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"NucID", "Mass"})
+		for _, n := range nucs {
+			cw.Write([]string{strconv.Itoa(n.NucID), strconv.FormatFloat(n.Mass, 'g', -1, 64)})
+		}
+		cw.Flush()
+		return cw.Error()
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "    ")
+		return enc.Encode(nucs)
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/rwcarlsen/source-sink/inventory/inv"
+)
+
+func doSeries(args []string) {
+	fs := flag.NewFlagSet("series", flag.ExitOnError)
+	simid := fs.String("simid", "", "Simulation id (may be omitted if the db holds only one).")
+	in := fs.String("in", "", "Cyclus sqlite db the inventory db was built from with -out, read for the cyclus tables.")
+	agent := fs.Int("agent", -1, "Id of the agent to report the inventory of.")
+	proto := fs.String("proto", "", "Report the combined inventory of all agents of this prototype.")
+	format := fs.String("format", "csv", "Output format (csv or json).")
+	fs.Usage = func() {
+		fmt.Println("Usage: inventory series [-simid id] [-in cyclus-db] -agent id|-proto name [db]")
+		fmt.Println("Prints the total inventory quantity held at each timestep.")
+		fmt.Println()
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 1 || (*agent < 0) == (*proto == "") {
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	conn, err := openWithInput(fs.Arg(0), *in)
+	fatalif(err)
+	defer conn.Close()
+
+	id, err := pickSimId(conn, *simid)
+	fatalif(err)
+
+	agents, err := pickAgents(conn, id, *agent, *proto)
+	fatalif(err)
+
+	series, err := inv.Series(conn, id, agents...)
+	fatalif(err)
+	fatalif(writeSeries(os.Stdout, *format, series))
+}
+
+// pickSimId returns the single simulation id in conn matching prefix,
+// or the only simulation id if prefix is empty.
+func pickSimId(conn *inv.Conn, prefix string) (string, error) {
+	simids, err := inv.GetSimIds(conn)
+	if err != nil {
+		return "", err
+	}
+	if prefix != "" {
+		if simids, err = inv.FilterSimIds(simids, prefix); err != nil {
+			return "", err
+		}
+	}
+
+	if len(simids) == 0 {
+		return "", fmt.Errorf("no simulations in database")
+	} else if len(simids) > 1 {
+		return "", fmt.Errorf("multiple simulations %v - choose one with -simid", simids)
+	}
+	return simids[0], nil
+}
+
+// pickAgents returns agent if proto is empty and otherwise the ids of all
+// agents of prototype proto in simulation simid.
+func pickAgents(conn *inv.Conn, simid string, agent int, proto string) ([]int, error) {
+	if proto == "" {
+		return []int{agent}, nil
+	}
+
+	agents, err := inv.PrototypeAgents(conn, simid, proto)
+	if err != nil {
+		return nil, err
+	} else if len(agents) == 0 {
+		return nil, fmt.Errorf("no agents with prototype %q in simid %v", proto, simid)
+	}
+	return agents, nil
+}
+
+type seriesPoint struct {
+	Time     int
+	Quantity float64
+}
+
+func writeSeries(w io.Writer, format string, series []float64) error {
+	switch format {
+	case "csv":
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"Time", "Quantity"})
+		for t, q := range series {
+			cw.Write([]string{strconv.Itoa(t), strconv.FormatFloat(q, 'g', -1, 64)})
+		}
+		cw.Flush()
+		return cw.Error()
+	case "json":
+		pts := make([]seriesPoint, len(series))
+		for t, q := range series {
+			pts[t] = seriesPoint{t, q}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "    ")
+		return enc.Encode(pts)
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+}